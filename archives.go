@@ -0,0 +1,187 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// 2023_TEOS_XML_05A.zip: one or more lettered parts per filing month
+	teosArchivePattern = regexp.MustCompile(`^(\d{4})_TEOS_XML_(\d{2})([A-Z]+)\.zip$`)
+	// download990xml_2020_3.zip: numbered parts per year, no month
+	legacyArchivePattern = regexp.MustCompile(`^download990xml_(\d{4})_(\d+)\.zip$`)
+)
+
+// ArchiveName is the parsed form of an IRS filing archive file name
+type ArchiveName struct {
+	File  string
+	Year  int
+	Month int    // 1-12, or 0 for legacy archives that are only numbered
+	Part  string // letter for monthly archives, sequence number for legacy ones
+}
+
+// ParseArchiveName recognizes both IRS archive naming schemes
+func ParseArchiveName(file string) (ArchiveName, bool) {
+	if m := teosArchivePattern.FindStringSubmatch(file); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		month, _ := strconv.Atoi(m[2])
+		if month < 1 || month > 12 {
+			return ArchiveName{}, false
+		}
+		return ArchiveName{File: file, Year: year, Month: month, Part: m[3]}, true
+	}
+	if m := legacyArchivePattern.FindStringSubmatch(file); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		return ArchiveName{File: file, Year: year, Part: m[2]}, true
+	}
+	return ArchiveName{}, false
+}
+
+// URL returns the canonical IRS location of the archive
+func (a ArchiveName) URL() string {
+	return fmt.Sprintf("%s%d/%s", irsXMLBase, a.Year, a.File)
+}
+
+// ArchiveFilter scopes sync to a set of archive (publication) years, filing
+// months and parts. The year is the one in the archive name, not the tax year
+// of the returns inside: a 2024 archive holds mostly 2022 and 2023 returns.
+// Zero values match everything.
+type ArchiveFilter struct {
+	FromYear, ToYear   int
+	FromMonth, ToMonth int
+	Parts              map[string]bool
+}
+
+// ParseArchiveFilter builds a filter from command line values: years and
+// months are single values or inclusive ranges ("2021-2023", "1-6"), parts a
+// comma separated list ("A,B")
+func ParseArchiveFilter(years, months, parts string) (ArchiveFilter, error) {
+	var filter ArchiveFilter
+	var err error
+
+	if filter.FromYear, filter.ToYear, err = parseRange(years); err != nil {
+		return filter, fmt.Errorf("invalid year filter: %w", err)
+	}
+	if filter.FromMonth, filter.ToMonth, err = parseRange(months); err != nil {
+		return filter, fmt.Errorf("invalid month filter: %w", err)
+	}
+	if filter.ToMonth > 12 {
+		return filter, fmt.Errorf("invalid month filter: %q is outside 1-12", months)
+	}
+
+	for _, part := range strings.Split(parts, ",") {
+		if part = strings.ToUpper(strings.TrimSpace(part)); part != "" {
+			if filter.Parts == nil {
+				filter.Parts = make(map[string]bool)
+			}
+			filter.Parts[part] = true
+		}
+	}
+
+	return filter, nil
+}
+
+// parseRange reads "N" or "N-M" with N at least 1; an empty value yields 0,
+// 0, which filters nothing
+func parseRange(value string) (from, to int, err error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, 0, nil
+	}
+
+	low, high, isRange := strings.Cut(value, "-")
+	if from, err = strconv.Atoi(strings.TrimSpace(low)); err != nil {
+		return 0, 0, fmt.Errorf("%q is not a number or range", value)
+	}
+	to = from
+	if isRange {
+		if to, err = strconv.Atoi(strings.TrimSpace(high)); err != nil {
+			return 0, 0, fmt.Errorf("%q is not a number or range", value)
+		}
+	}
+	if from < 1 {
+		return 0, 0, fmt.Errorf("%q starts below 1", value)
+	}
+	if to < from {
+		return 0, 0, fmt.Errorf("%q ends before it starts", value)
+	}
+	return from, to, nil
+}
+
+// Empty reports whether the filter matches every archive
+func (f ArchiveFilter) Empty() bool {
+	return f.FromYear == 0 && f.FromMonth == 0 && len(f.Parts) == 0
+}
+
+// Match reports whether an archive file name passes the filter. Names that do
+// not follow a known scheme only pass an empty filter, and legacy archives
+// have no month so they never pass a month filter.
+func (f ArchiveFilter) Match(file string) bool {
+	if f.Empty() {
+		return true
+	}
+
+	archive, ok := ParseArchiveName(file)
+	if !ok {
+		return false
+	}
+	if f.FromYear != 0 && (archive.Year < f.FromYear || archive.Year > f.ToYear) {
+		return false
+	}
+	if f.FromMonth != 0 && (archive.Month < f.FromMonth || archive.Month > f.ToMonth) {
+		return false
+	}
+	if len(f.Parts) > 0 && !f.Parts[archive.Part] {
+		return false
+	}
+	return true
+}
+
+// Apply keeps the URLs whose archive names pass the filter
+func (f ArchiveFilter) Apply(urls []string) []string {
+	if f.Empty() {
+		return urls
+	}
+
+	var kept []string
+	for _, url := range urls {
+		if f.Match(extractFilenameFromURL(url)) {
+			kept = append(kept, url)
+		}
+	}
+	return kept
+}
+
+// String describes the filter for log output
+func (f ArchiveFilter) String() string {
+	if f.Empty() {
+		return "all archives"
+	}
+
+	var parts []string
+	if f.FromYear != 0 {
+		parts = append(parts, "archive years "+formatRange(f.FromYear, f.ToYear))
+	}
+	if f.FromMonth != 0 {
+		parts = append(parts, "months "+formatRange(f.FromMonth, f.ToMonth))
+	}
+	if len(f.Parts) > 0 {
+		letters := make([]string, 0, len(f.Parts))
+		for part := range f.Parts {
+			letters = append(letters, part)
+		}
+		sort.Strings(letters)
+		parts = append(parts, "parts "+strings.Join(letters, ","))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func formatRange(from, to int) string {
+	if from == to {
+		return strconv.Itoa(from)
+	}
+	return fmt.Sprintf("%d-%d", from, to)
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	backfillLedgerPath = "./data/backfill.json"
+	// maxLegacyParts bounds the numbered parts probed for one legacy year
+	maxLegacyParts = 99
+	// teosPartLetters are the part letters probed for one TEOS month
+	teosPartLetters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+// ArchiveProbe records an archive a backfill found upstream
+type ArchiveProbe struct {
+	URL          string    `json:"url"`
+	Size         int64     `json:"size"`
+	LastModified string    `json:"last_modified,omitempty"`
+	ProbedAt     time.Time `json:"probed_at"`
+}
+
+// BackfillLedger records which guessed archive names exist upstream, keyed by
+// file name, so later backfills only probe names they haven't seen
+type BackfillLedger struct {
+	path     string
+	Archives map[string]*ArchiveProbe `json:"archives"`
+}
+
+// BackfillOptions scope a backfill
+type BackfillOptions struct {
+	// Filter limits the years and months probed and the parts downloaded.
+	// It must name the years: the IRS has never listed how far back the
+	// archives go, so there is no safe default.
+	Filter ArchiveFilter
+	// DryRun probes and lists the archives without downloading them
+	DryRun bool
+	Store  Storage
+	// SkipPreflight downloads even when the free space looks too small
+	SkipPreflight bool
+}
+
+// LoadBackfillLedger reads the ledger at path, returning an empty one if it does not exist yet
+func LoadBackfillLedger(path string) (*BackfillLedger, error) {
+	ledger := &BackfillLedger{path: path, Archives: make(map[string]*ArchiveProbe)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ledger, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backfill ledger: %w", err)
+	}
+	if err := json.Unmarshal(data, ledger); err != nil {
+		return nil, fmt.Errorf("failed to parse backfill ledger: %w", err)
+	}
+	if ledger.Archives == nil {
+		ledger.Archives = make(map[string]*ArchiveProbe)
+	}
+	return ledger, nil
+}
+
+// Save writes the ledger atomically
+func (l *BackfillLedger) Save() error {
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return fmt.Errorf("failed to create ledger directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode backfill ledger: %w", err)
+	}
+
+	tmp := l.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write backfill ledger: %w", err)
+	}
+	return os.Rename(tmp, l.path)
+}
+
+// archiveSequences lists every archive name the IRS could have published for
+// the years and months in scope, one sequence per run of parts. The IRS
+// numbers parts consecutively, so a sequence ends at its first missing name.
+// Both naming schemes are tried for every year since the switch from
+// download990xml_YYYY_N.zip to YYYY_TEOS_XML_MMP.zip was not on a year boundary
+// for every series.
+func archiveSequences(fromYear, toYear, fromMonth, toMonth int) [][]ArchiveName {
+	if fromMonth == 0 {
+		fromMonth, toMonth = 1, 12
+	}
+
+	var sequences [][]ArchiveName
+	for year := fromYear; year <= toYear; year++ {
+		var legacy []ArchiveName
+		for n := 1; n <= maxLegacyParts; n++ {
+			file := fmt.Sprintf("download990xml_%d_%d.zip", year, n)
+			legacy = append(legacy, ArchiveName{File: file, Year: year, Part: fmt.Sprint(n)})
+		}
+		sequences = append(sequences, legacy)
+
+		for month := fromMonth; month <= toMonth; month++ {
+			var teos []ArchiveName
+			for _, letter := range teosPartLetters {
+				file := fmt.Sprintf("%d_TEOS_XML_%02d%c.zip", year, month, letter)
+				teos = append(teos, ArchiveName{File: file, Year: year, Month: month, Part: string(letter)})
+			}
+			sequences = append(sequences, teos)
+		}
+	}
+	return sequences
+}
+
+// probeArchives walks each sequence with HEAD requests until a name is
+// missing. Names the ledger already holds are trusted without a request.
+func probeArchives(src Source, ledger *BackfillLedger, sequences [][]ArchiveName) (found []ArchiveName, probes int, errs []error) {
+	var mu sync.Mutex
+	queue := make(chan []ArchiveName)
+	var wg sync.WaitGroup
+	for w := 0; w < defaultDownloadWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for sequence := range queue {
+				for _, archive := range sequence {
+					mu.Lock()
+					_, known := ledger.Archives[archive.File]
+					mu.Unlock()
+					if known {
+						mu.Lock()
+						found = append(found, archive)
+						mu.Unlock()
+						continue
+					}
+
+					remote, err := src.Head(archive.URL())
+					mu.Lock()
+					probes++
+					if err == nil {
+						ledger.Archives[archive.File] = &ArchiveProbe{
+							URL:          archive.URL(),
+							Size:         remote.Size,
+							LastModified: remote.LastModified,
+							ProbedAt:     time.Now().UTC(),
+						}
+						found = append(found, archive)
+					} else if !errors.Is(err, ErrNotFound) {
+						errs = append(errs, err)
+					}
+					mu.Unlock()
+					if err != nil {
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	for _, sequence := range sequences {
+		queue <- sequence
+	}
+	close(queue)
+	wg.Wait()
+
+	sort.Slice(found, func(i, j int) bool { return found[i].File < found[j].File })
+	return found, probes, errs
+}
+
+// Backfill finds every historical archive by probing the names the IRS has
+// used over the years, records what exists in the backfill ledger, and
+// downloads the archives into data/990_zips with their catalog metadata
+func Backfill(src Source, opts BackfillOptions) (*SyncReport, error) {
+	store := opts.Store
+	if store == nil {
+		store = &LocalStorage{Root: "."}
+	}
+	fromYear, toYear := opts.Filter.FromYear, opts.Filter.ToYear
+	if fromYear == 0 {
+		return nil, errors.New("backfill needs the years to probe, e.g. -year 2015-2019")
+	}
+
+	ledger, err := LoadBackfillLedger(backfillLedgerPath)
+	if err != nil {
+		return nil, err
+	}
+
+	fmt.Printf("Probing archive names for %d-%d on %s...\n", fromYear, toYear, src.Name())
+	sequences := archiveSequences(fromYear, toYear, opts.Filter.FromMonth, opts.Filter.ToMonth)
+	found, probes, errs := probeArchives(src, ledger, sequences)
+	if err := ledger.Save(); err != nil {
+		return nil, err
+	}
+
+	policy, err := LoadRetentionPolicy(retentionPath)
+	if err != nil {
+		return nil, err
+	}
+
+	byYear := make(map[int]int)
+	var urls []string
+	for _, archive := range found {
+		if !opts.Filter.Match(archive.File) {
+			continue
+		}
+		byYear[archive.Year]++
+		urls = append(urls, archive.URL())
+	}
+	urls = policy.Apply(urls)
+	fmt.Printf("Sent %d probes; %d archives exist, %d in scope\n", probes, len(found), len(urls))
+	for year := fromYear; year <= toYear; year++ {
+		if byYear[year] > 0 {
+			fmt.Printf("  %d: %d archives\n", year, byYear[year])
+		}
+	}
+	for _, err := range errs {
+		fmt.Printf("Probe failed: %v\n", err)
+	}
+
+	catalog, err := LoadCatalog(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	preflight := downloadPreflight(src, catalog, store, urls)
+	if opts.DryRun {
+		printSyncPlan(urls, catalog, store, "./data/990_zips")
+		preflight.Print(os.Stdout)
+		return &SyncReport{}, nil
+	}
+	if !opts.SkipPreflight {
+		if err := preflight.Check(); err != nil {
+			return nil, err
+		}
+	}
+
+	report := fetchArchives(context.Background(), src, catalog, store, urls, false)
+	catalog.MarkBackfilled(urls)
+	if err := catalog.Save(); err != nil {
+		return report, err
+	}
+
+	fmt.Printf("Backfill complete! Downloaded %d files (%s), %d unchanged.\n", len(report.Downloaded), formatBytes(report.Bytes), report.Unchanged)
+	report.PrintFilingChanges(os.Stdout)
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%d of %d downloads failed; run backfill again to resume them", report.Failed, len(urls))
+	}
+	if len(errs) > 0 {
+		return report, fmt.Errorf("%d probes failed; run backfill again to finish probing", len(errs))
+	}
+	return report, nil
+}
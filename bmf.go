@@ -0,0 +1,342 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	bmfDir       = "./data/eo_bmf"
+	bmfTableFile = "bmf.csv"
+	irsSOIBase   = "https://www.irs.gov/pub/irs-soi/"
+)
+
+// bmfRegionFiles together cover every organization in the EO BMF extract
+var bmfRegionFiles = []string{"eo1.csv", "eo2.csv", "eo3.csv", "eo4.csv"}
+
+// bmfColumns are the extract columns kept in the local table
+var bmfColumns = []string{
+	"EIN",
+	"NAME",
+	"STATE",
+	"SUBSECTION",
+	"CLASSIFICATION",
+	"AFFILIATION",
+	"RULING",
+	"DEDUCTIBILITY",
+	"FOUNDATION",
+	"ORGANIZATION",
+	"STATUS",
+	"NTEE_CD",
+}
+
+// bmfJoinColumns are appended to CSV output when joining on EIN
+var bmfJoinColumns = []string{
+	"BMFName",
+	"NTEECode",
+	"Subsection",
+	"Classification",
+	"Affiliation",
+	"FoundationCode",
+	"RulingDate",
+	"Deductibility",
+	"OrganizationType",
+	"ExemptStatus",
+}
+
+// EINJoin appends columns looked up by EIN to each CSV record
+type EINJoin interface {
+	Columns() []string
+	// Values returns one value per column, empty when the EIN is unknown
+	Values(ein string) []string
+}
+
+// BMFRecord is one organization in the EO Business Master File extract
+type BMFRecord struct {
+	EIN            string
+	Name           string
+	State          string
+	Subsection     string
+	Classification string
+	Affiliation    string
+	Ruling         string
+	Deductibility  string
+	Foundation     string
+	Organization   string
+	Status         string
+	NTEE           string
+}
+
+// BMFTable holds the EO BMF extract keyed by EIN
+type BMFTable struct {
+	Records map[string]*BMFRecord
+}
+
+// bmfURLs lists the extract files to fetch: the regional files, or the
+// per-state files when states are given
+func bmfURLs(states []string) []string {
+	var urls []string
+	for _, state := range states {
+		if state = strings.ToLower(strings.TrimSpace(state)); state != "" {
+			urls = append(urls, fmt.Sprintf("%seo_%s.csv", irsSOIBase, state))
+		}
+	}
+	if len(urls) > 0 {
+		return urls
+	}
+	for _, file := range bmfRegionFiles {
+		urls = append(urls, irsSOIBase+file)
+	}
+	return urls
+}
+
+// SyncBMF downloads the EO BMF extract files that are missing or changed
+func SyncBMF(src Source, states []string) error {
+	var jobs []DownloadJob
+	for _, link := range bmfURLs(states) {
+		jobs = append(jobs, conditionalJob(bmfDir, link))
+	}
+
+	fmt.Printf("Fetching %d EO BMF files from %s...\n", len(jobs), src.Name())
+	results := NewDownloader(src, bmfDir, defaultDownloadWorkers).Download(jobs)
+
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed == len(results) {
+		return fmt.Errorf("failed to download any EO BMF files")
+	}
+	return nil
+}
+
+// BuildBMFTable parses every extract file in dir
+func BuildBMFTable(dir string) (*BMFTable, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read EO BMF directory: %w", err)
+	}
+
+	table := &BMFTable{Records: make(map[string]*BMFRecord)}
+	for _, entry := range entries {
+		name := strings.ToLower(entry.Name())
+		if entry.IsDir() || !strings.HasPrefix(name, "eo") || !strings.HasSuffix(name, ".csv") {
+			continue
+		}
+
+		count, err := table.parseExtract(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("Parsed %d organizations from %s\n", count, entry.Name())
+	}
+	return table, nil
+}
+
+// parseExtract reads one extract file, locating columns by header name. It
+// also reads the table written by Save, which uses the same column names.
+func (t *BMFTable) parseExtract(path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open EO BMF file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read header of %s: %w", path, err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["EIN"]; !ok {
+		return 0, fmt.Errorf("EO BMF file %s has no EIN column", path)
+	}
+
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var count int
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		ein := normalizeEIN(field(row, "EIN"))
+		if ein == "" {
+			continue
+		}
+		t.Records[ein] = &BMFRecord{
+			EIN:            ein,
+			Name:           field(row, "NAME"),
+			State:          field(row, "STATE"),
+			Subsection:     field(row, "SUBSECTION"),
+			Classification: field(row, "CLASSIFICATION"),
+			Affiliation:    field(row, "AFFILIATION"),
+			Ruling:         field(row, "RULING"),
+			Deductibility:  field(row, "DEDUCTIBILITY"),
+			Foundation:     field(row, "FOUNDATION"),
+			Organization:   field(row, "ORGANIZATION"),
+			Status:         field(row, "STATUS"),
+			NTEE:           field(row, "NTEE_CD"),
+		}
+		count++
+	}
+	return count, nil
+}
+
+// row returns the record's values in bmfColumns order
+func (r *BMFRecord) row() []string {
+	return []string{
+		r.EIN,
+		r.Name,
+		r.State,
+		r.Subsection,
+		r.Classification,
+		r.Affiliation,
+		r.Ruling,
+		r.Deductibility,
+		r.Foundation,
+		r.Organization,
+		r.Status,
+		r.NTEE,
+	}
+}
+
+// Save writes the table as a single CSV sorted by EIN
+func (t *BMFTable) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create EO BMF directory: %w", err)
+	}
+
+	eins := make([]string, 0, len(t.Records))
+	for ein := range t.Records {
+		eins = append(eins, ein)
+	}
+	sort.Strings(eins)
+
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create EO BMF table: %w", err)
+	}
+
+	writer := csv.NewWriter(file)
+	writer.Write(bmfColumns)
+	for _, ein := range eins {
+		writer.Write(t.Records[ein].row())
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write EO BMF table: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close EO BMF table: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// LoadBMFTable reads the table written by Save
+func LoadBMFTable(path string) (*BMFTable, error) {
+	table := &BMFTable{Records: make(map[string]*BMFRecord)}
+	if _, err := table.parseExtract(path); err != nil {
+		return nil, fmt.Errorf("failed to load EO BMF table (run the bmf command first): %w", err)
+	}
+	return table, nil
+}
+
+// Lookup returns the organization with the given EIN
+func (t *BMFTable) Lookup(ein string) (*BMFRecord, bool) {
+	r, ok := t.Records[normalizeEIN(ein)]
+	return r, ok
+}
+
+// Columns implements EINJoin
+func (t *BMFTable) Columns() []string {
+	return bmfJoinColumns
+}
+
+// Values implements EINJoin
+func (t *BMFTable) Values(ein string) []string {
+	r, ok := t.Lookup(ein)
+	if !ok {
+		return make([]string, len(bmfJoinColumns))
+	}
+	return []string{
+		r.Name,
+		r.NTEE,
+		r.Subsection,
+		r.Classification,
+		r.Affiliation,
+		r.Foundation,
+		r.Ruling,
+		r.Deductibility,
+		r.Organization,
+		r.Status,
+	}
+}
+
+// Summary prints organization counts by subsection
+func (t *BMFTable) Summary(w io.Writer) {
+	bySubsection := make(map[string]int)
+	var withNTEE int
+	for _, r := range t.Records {
+		bySubsection[r.Subsection]++
+		if r.NTEE != "" {
+			withNTEE++
+		}
+	}
+
+	subsections := make([]string, 0, len(bySubsection))
+	for subsection := range bySubsection {
+		subsections = append(subsections, subsection)
+	}
+	sort.Strings(subsections)
+
+	fmt.Fprintf(w, "EO BMF holds %d organizations, %d with an NTEE code\n", len(t.Records), withNTEE)
+	for _, subsection := range subsections {
+		label := strings.TrimLeft(subsection, "0")
+		if label == "" {
+			label = subsection
+		}
+		fmt.Fprintf(w, "  501(c)(%s): %d\n", label, bySubsection[subsection])
+	}
+}
+
+// RebuildBMF fetches the extract and rewrites the local table
+func RebuildBMF(src Source, states []string) error {
+	if err := SyncBMF(src, states); err != nil {
+		return err
+	}
+
+	table, err := BuildBMFTable(bmfDir)
+	if err != nil {
+		return err
+	}
+	table.Summary(os.Stdout)
+
+	return table.Save(filepath.Join(bmfDir, bmfTableFile))
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+const catalogPath = "./data/catalog.json"
+
+// CatalogEntry records where an archive came from and what we downloaded
+type CatalogEntry struct {
+	URL          string    `json:"url"`
+	File         string    `json:"file"`
+	Size         int64     `json:"size"`
+	SHA256       string    `json:"sha256"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	DownloadedAt time.Time `json:"downloaded_at"`
+	// GoneSince is set when the archive stops being listed upstream
+	GoneSince *time.Time `json:"gone_since,omitempty"`
+	// QuarantinedAt is set when the local copy failed verification
+	QuarantinedAt *time.Time `json:"quarantined_at,omitempty"`
+	// Backfilled is set on archives a backfill found that the downloads page
+	// does not list, so their absence from it is expected
+	Backfilled bool `json:"backfilled,omitempty"`
+}
+
+// Catalog is the persistent record of every archive that sync has seen,
+// keyed by file name
+type Catalog struct {
+	path    string
+	Entries map[string]*CatalogEntry `json:"archives"`
+}
+
+// CatalogChanges summarizes how the upstream listing differs from the catalog
+type CatalogChanges struct {
+	Added    []string
+	Changed  []string
+	Gone     []string
+	Returned []string
+}
+
+// LoadCatalog reads the catalog at path, returning an empty one if it does not exist yet
+func LoadCatalog(path string) (*Catalog, error) {
+	catalog := &Catalog{path: path, Entries: make(map[string]*CatalogEntry)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return catalog, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog: %w", err)
+	}
+
+	if err := json.Unmarshal(data, catalog); err != nil {
+		return nil, fmt.Errorf("failed to parse catalog %s: %w", path, err)
+	}
+	if catalog.Entries == nil {
+		catalog.Entries = make(map[string]*CatalogEntry)
+	}
+	return catalog, nil
+}
+
+// Save writes the catalog through a temporary file so a crash never leaves it half written
+func (c *Catalog) Save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode catalog: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("failed to create catalog directory: %w", err)
+	}
+
+	tmp := c.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write catalog: %w", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("failed to replace catalog: %w", err)
+	}
+	return nil
+}
+
+// Job builds a download job for url that is conditional on what the catalog
+// last saw. Archives downloaded before the catalog existed are conditioned on
+// the stored copy's modification time, and missing files are always fetched.
+func (c *Catalog) Job(url string, store Storage, key string) DownloadJob {
+	job := DownloadJob{URL: url}
+	info, err := store.Stat(key)
+	if err != nil {
+		return job
+	}
+
+	if entry, ok := c.Entries[extractFilenameFromURL(url)]; ok && entry.SHA256 != "" {
+		job.ETag = entry.ETag
+		job.LastModified = entry.LastModified
+	}
+	if job.ETag == "" && job.LastModified == "" {
+		job.LastModified = info.ModTime.UTC().Format(http.TimeFormat)
+	}
+	return job
+}
+
+// Record stores a download result and reports whether the archive content
+// differs from what was cataloged before. The result's path is a key in store.
+func (c *Catalog) Record(store Storage, result DownloadResult) (changed bool, err error) {
+	file := filepath.Base(result.Path)
+	entry, known := c.Entries[file]
+	if !known {
+		entry = &CatalogEntry{File: file}
+		c.Entries[file] = entry
+	}
+
+	entry.URL = result.URL
+	if result.ETag != "" {
+		entry.ETag = result.ETag
+	}
+	if result.LastModified != "" {
+		entry.LastModified = result.LastModified
+	}
+
+	if result.NotModified {
+		// Adopt archives downloaded before the catalog existed
+		if entry.SHA256 == "" {
+			key := filepath.ToSlash(result.Path)
+			info, err := store.Stat(key)
+			if err != nil {
+				return false, fmt.Errorf("failed to stat %s: %w", result.Path, err)
+			}
+			sum, size, err := hashObject(store, key)
+			if err != nil {
+				return false, err
+			}
+			entry.SHA256 = sum
+			entry.Size = size
+			entry.DownloadedAt = info.ModTime.UTC()
+		}
+		return false, nil
+	}
+
+	// A replacement for a quarantined copy is a repair, not an upstream change
+	changed = known && entry.QuarantinedAt == nil && entry.SHA256 != "" && entry.SHA256 != result.SHA256
+	entry.QuarantinedAt = nil
+	entry.Size = result.Bytes
+	entry.SHA256 = result.SHA256
+	entry.DownloadedAt = time.Now().UTC()
+	return changed, nil
+}
+
+// MarkQuarantined records that the local copy of an archive failed verification
+func (c *Catalog) MarkQuarantined(file, url string) {
+	entry, ok := c.Entries[file]
+	if !ok {
+		entry = &CatalogEntry{File: file, URL: url}
+		c.Entries[file] = entry
+	}
+	now := time.Now().UTC()
+	entry.QuarantinedAt = &now
+}
+
+// quarantined returns the urls whose archive last failed verification
+func (c *Catalog) quarantined(urls []string) []string {
+	var found []string
+	for _, url := range urls {
+		if entry, ok := c.Entries[extractFilenameFromURL(url)]; ok && entry.QuarantinedAt != nil {
+			found = append(found, url)
+		}
+	}
+	return found
+}
+
+// MarkBackfilled records that the archives at urls were found by a backfill.
+// A mark left on one that was only thought gone is cleared.
+func (c *Catalog) MarkBackfilled(urls []string) {
+	for _, url := range urls {
+		if entry, ok := c.Entries[extractFilenameFromURL(url)]; ok {
+			entry.Backfilled = true
+			entry.GoneSince = nil
+		}
+	}
+}
+
+// Reconcile compares the upstream listing with the catalog, marking archives
+// that disappeared and clearing the mark on ones that came back. Backfilled
+// archives are only reconciled once the listing has included them.
+func (c *Catalog) Reconcile(urls []string) CatalogChanges {
+	var changes CatalogChanges
+	listed := make(map[string]bool, len(urls))
+	for _, url := range urls {
+		file := extractFilenameFromURL(url)
+		listed[file] = true
+
+		entry, ok := c.Entries[file]
+		if !ok {
+			changes.Added = append(changes.Added, file)
+			continue
+		}
+		entry.Backfilled = false
+		if entry.GoneSince != nil {
+			entry.GoneSince = nil
+			changes.Returned = append(changes.Returned, file)
+		}
+	}
+
+	now := time.Now().UTC()
+	for file, entry := range c.Entries {
+		if listed[file] || entry.GoneSince != nil || entry.Backfilled {
+			continue
+		}
+		entry.GoneSince = &now
+		changes.Gone = append(changes.Gone, file)
+	}
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Gone)
+	sort.Strings(changes.Returned)
+	return changes
+}
+
+// Print writes a human readable summary of the changes
+func (c CatalogChanges) Print(w io.Writer) {
+	report := func(label string, files []string) {
+		if len(files) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "%s upstream (%d):\n", label, len(files))
+		for _, file := range files {
+			fmt.Fprintf(w, "  %s\n", file)
+		}
+	}
+
+	report("Added", c.Added)
+	report("Changed", c.Changed)
+	report("Disappeared", c.Gone)
+	report("Reappeared", c.Returned)
+	if len(c.Added)+len(c.Changed)+len(c.Gone)+len(c.Returned) == 0 {
+		fmt.Fprintln(w, "No upstream changes since the last sync.")
+	}
+}
+
+// hashFile returns the SHA-256 and size of a file
+func hashFile(path string) (string, int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to hash %s: %w", path, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
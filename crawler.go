@@ -1,395 +1,320 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io"
-	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
-	"sync/atomic"
-
-	"golang.org/x/net/html"
 )
 
-type Crawler struct {}
+// UnpackSchemas caches every schema version listed on the IRS schemas page
+// that isn't cached yet and records it in the schema registry. Cached versions
+// are kept side by side, so older filings can still be read with their schema.
+func UnpackSchemas(src Source) (*SchemaRegistry, error) {
+	registry, err := LoadSchemaRegistry(schemaRegistryPath)
+	if err != nil {
+		return nil, err
+	}
 
-const (
-    upTo20 = `https://apps.irs.gov/pub/epostcard/990/xml/`
-    post20 = `https://apps.irs.gov/pub/epostcard/990/xml/`
-    currentStart = 2019
-    currentYear = 2025
-)
+	links, err := pageLinks(src, irsSchemasPage, ".zip")
+	if err != nil {
+		return nil, err
+	}
 
-var fileTracker atomic.Int32
-var (
-    fileYear = ""
-)
+	if err := os.MkdirAll(xsdCacheDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
 
-type Version struct {
-    Schedule string
-    Major int
-    Minor int
-    Sep string
-}
+	var added, fetched int
+	for _, uri := range links {
+		version, err := ParseSchemaArchive(uri)
+		if err != nil {
+			fmt.Printf("Skipping %s: %v\n", uri, err)
+			continue
+		}
+		if known := registry.Lookup(version.Version, version.Family); known != nil && known.Cached() {
+			continue
+		}
 
-var ledger map[string]Version
-
-func ScrapeURLs() {
-    var template string
-    for year := currentStart; year <= currentYear; year++ {
-        counter := 12
-        for counter > 0 {
-            if year < 2021 {
-                template = upTo20 + fmt.Sprintf(`%d/download990xml_%d_%d.zip`, year, year, counter)
-            } else {
-                template = upTo20 + fmt.Sprintf(`%d/%d_TEOS_XML_%02dA.zip`, year, year, counter)
-            }
-            fmt.Println(template)
-            res, err := http.Get(template)
-            if err != nil {
-                fmt.Println(err)
-            }
-            defer res.Body.Close()
-
-            out, err := os.Create(fmt.Sprintf(`%d_%d.zip`, year, counter))
-            if err != nil {
-                fmt.Println(err)
-            }
-            defer out.Close()
-
-            _, err = io.Copy(out, res.Body)
-            fmt.Println(err)
-            counter--
-        }
-    }
-}
+		if err := cacheSchema(src, version); err != nil {
+			registry.Save()
+			return registry, err
+		}
+		fetched++
+		if registry.Register(version) {
+			added++
+		}
+	}
 
-func UnpackSchemas() (map[string]Version, error) {
-    ledger = make(map[string]Version)
-    res, err := http.Get("https://www.irs.gov/charities-non-profits/tax-exempt-organization-search-teos-schemas")
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer res.Body.Close()
-
-    doc, err := html.Parse(res.Body)
-    if err != nil {
-        fmt.Println(err)
-    }
-
-    os.Mkdir("./data/990_xsd", 0777)
-
-    var walk func(*html.Node)
-    walk = func(n *html.Node) {
-        if n.Type == html.ElementNode && n.Data == "a" {
-            for _, attr := range n.Attr {
-                if attr.Key == "href" {
-                    if strings.Contains(attr.Val, ".zip") {
-                        fetchSchema(attr.Val)
-                    }
-                }
-            }
-        }
-        for c := n.FirstChild; c != nil; c = c.NextSibling {
-            walk(c)
-        }
-    }
-    walk(doc)
-    fmt.Printf("%+v", ledger)
-    return ledger, nil
+	if err := registry.Save(); err != nil {
+		return registry, err
+	}
+	fmt.Printf("Schema registry: %d versions, %d new, %d fetched into %s\n", len(registry.Versions), added, fetched, xsdCacheDir)
+	return registry, nil
 }
 
-func UnpackZips() ([]string, error) {
-    res, err := http.Get("https://www.irs.gov/charities-non-profits/form-990-series-downloads")
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer res.Body.Close()
-
-    doc, err := html.Parse(res.Body)
-    if err != nil {
-        fmt.Println(err)
-    }
-
-    os.Mkdir(`./data/990_zips/`, 0777) 
-
-    var links []string
-    var walk func(*html.Node)
-    walk = func(n *html.Node) {
-        if n.Type == html.ElementNode && n.Data == "a" {
-            for _, attr := range n.Attr {
-                if attr.Key == "href" {
-                    if strings.Contains(attr.Val, ".zip") {
-                        links = append(links, attr.Val)
-                    }
-                }
-            }
-        }
-        for c := n.FirstChild; c != nil; c = c.NextSibling {
-            walk(c)
-        }
-    }
-    walk(doc)
-    
-    var zipData []string
-    for _, uri := range links {
-        zipData = append(zipData, fetchZip(uri))
-    }
-    return links, nil
-}
+// UnpackZips downloads every archive in scope into store again, including
+// the ones already held. Transfers go through the Downloader like those of
+// sync, so they are staged, verified and cataloged before replacing a copy.
+func UnpackZips(src Source, store Storage, filter ArchiveFilter) ([]string, error) {
+	links, err := pageLinks(src, irsDownloadsPage, ".zip")
+	if err != nil {
+		return nil, err
+	}
+	links = filter.Apply(links)
 
-func splitYear(uri string, strategy string) string {
-    switch strategy {
-    case "schema":
-        fmt.Println(uri)
-        res := strings.Split(uri, "/")
-        schedule := res[5]
-        
-        var sep string
-        var major, minor int
-        var test, register []string
-        if strings.Contains(schedule, "v") {
-            test = strings.Split(schedule, "v")
-            register = strings.Split(test[0], "-")
-        } else {
-            register = strings.Split(schedule, "-")
-        }
-         
-        if len(test) > 0 {
-            var erra error
-            major, erra = strconv.Atoi(string(test[1][0]))
-            if erra != nil {
-                fmt.Println(erra)
-            }
-
-            minor, erra = strconv.Atoi(string(test[1][2]))
-            if erra != nil {
-                fmt.Println(erra)
-            }
-            sep = string(test[1][1])
-        }
-
-        year := register[len(register) - 1]
-        key := year + ":" + string(register[0][3])
-        if found, ok := ledger[key]; ok {
-            if found.Major < major {
-                ledger[key] = Version{
-                    Schedule: schedule,
-                    Major: major,
-                    Minor: minor,
-                    Sep: sep,
-                }
-            } else if found.Major == major {
-                if found.Minor < minor {
-                    ledger[key] = Version{
-                        Schedule: schedule,
-                        Major: major,
-                        Minor: minor,
-                        Sep: sep,
-                    } 
-                }
-            }
-        } else {
-            ledger[key] = Version{
-                Schedule: schedule,
-                Major: major,
-                Minor: minor,
-                Sep: sep,
-            } 
-        }
-
-        return res[5]
-    case "zips":
-        res := strings.Split(uri, "/")
-        if len(res) >= 8 {
-            return res[6]
-        }
-        return ""
-    }
-
-    return "" 
+	catalog, err := LoadCatalog(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	report := fetchArchives(context.Background(), src, catalog, store, links, true)
+	if err := catalog.Save(); err != nil {
+		return report.Downloaded, err
+	}
+	if report.Failed > 0 {
+		return report.Downloaded, fmt.Errorf("%d of %d downloads failed", report.Failed, len(links))
+	}
+	return report.Downloaded, nil
 }
 
+// copyRemote downloads link from src into path and returns its Last-Modified value
+func copyRemote(src Source, link, path string) (string, error) {
+	res, err := src.Open(link, OpenOptions{})
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	out, err := os.Create(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to create file: %w", err)
+	}
+
+	if _, err := io.Copy(out, res.Body); err != nil {
+		out.Close()
+		return "", fmt.Errorf("failed to download %s: %w", link, err)
+	}
+	return res.LastModified, out.Close()
+}
 
-func fetchSchema(uri string) {
-    fmt.Println(uri)
-    res, err := http.Get(uri)
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer res.Body.Close()
-
-    year := splitYear(uri, "schema")
-    if year != fileYear {
-        fileTracker.Store(0)
-        fileYear = year
-    }
-    fmt.Println(year)
-    out, err := os.Create(fmt.Sprintf(`./data/990_xsd/%s`, year))
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer out.Close()
-
-    _, err = io.Copy(out, res.Body)
-    if err != nil {
-        log.Println(err)
-    }
+// SyncOptions scope a sync run
+type SyncOptions struct {
+	Filter ArchiveFilter
+	// DryRun lists the archives in scope without downloading anything
+	DryRun bool
+	// Store holds the archives; nil means data/990_zips on local disk
+	Store Storage
+	// SkipPreflight downloads even when the free space looks too small
+	SkipPreflight bool
 }
 
-func fetchZip(uri string) string {
-    res, err := http.Get(uri)
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer res.Body.Close()
-
-    // Extract the filename from the URL
-    urlParts := strings.Split(uri, "/")
-    if len(urlParts) == 0 {
-        fmt.Println("Invalid URL:", uri)
-        return ""
-    }
-    
-    filename := urlParts[len(urlParts)-1]
-    
-    // Create the full path for the downloaded file
-    tracker := fmt.Sprintf(`./data/990_zips/%s`, filename)
-    out, err := os.Create(tracker)
-    if err != nil {
-        fmt.Println(err)
-    }
-    defer out.Close()
-
-    _, err = io.Copy(out, res.Body)
-    if err != nil {
-        fmt.Println("Error copying file:", err)
-    } else {
-        fmt.Printf("Downloaded: %s\n", filename)
-    }
-    
-    return tracker 
+// SyncReport lists what a sync run fetched
+type SyncReport struct {
+	// Downloaded holds the file names of archives that are new or changed
+	// upstream, each verified before it was kept
+	Downloaded []string
+	// Changed holds the downloaded archives whose content differs from
+	// the cataloged copy
+	Changed   []string
+	Unchanged int
+	Failed    int
+	// Quarantined holds archives that failed verification this run
+	Quarantined []string
+	Bytes       int64
+	// FilingChanges lists the filings added, removed or modified in each
+	// changed archive whose previous copy had been listed
+	FilingChanges []FilingChanges
 }
 
-// CheckAndDownloadMissingZips checks what files are already downloaded and downloads only the missing ones
-func CheckAndDownloadMissingZips() error {
-	fmt.Println("Checking for missing zip files...")
-	
-	// Get list of available files from IRS website
-	availableFiles, err := getAvailableZipFiles()
+// CheckAndDownloadMissingZips downloads archives that are missing locally and
+// re-fetches any whose content changed upstream since the last sync. Once ctx
+// is done no further download is started.
+func CheckAndDownloadMissingZips(ctx context.Context, src Source, opts SyncOptions) (*SyncReport, error) {
+	fmt.Printf("Checking for missing or changed zip files on %s (%s)...\n", src.Name(), opts.Filter)
+
+	zipDir := "./data/990_zips"
+	store := opts.Store
+	if store == nil {
+		store = &LocalStorage{Root: "."}
+	}
+	catalog, err := LoadCatalog(catalogPath)
 	if err != nil {
-		return fmt.Errorf("failed to get available files: %w", err)
+		return nil, err
 	}
-	
-	// Get list of already downloaded files
-	downloadedFiles, err := getDownloadedZipFiles()
+
+	// Get list of available files from the source
+	availableFiles, err := getAvailableZipFiles(src)
 	if err != nil {
-		return fmt.Errorf("failed to get downloaded files: %w", err)
+		return nil, fmt.Errorf("failed to get available files: %w", err)
 	}
-	
-	// Find missing files
-	missingFiles := findMissingFiles(availableFiles, downloadedFiles)
-	
-	if len(missingFiles) == 0 {
-		fmt.Println("✓ All files are already downloaded!")
-		return nil
+	changes := catalog.Reconcile(availableFiles)
+
+	// Reconcile sees the whole listing so out-of-scope archives are not
+	// mistaken for withdrawn ones; only the scoped set is fetched
+	scoped := opts.Filter.Apply(availableFiles)
+	policy, err := LoadRetentionPolicy(retentionPath)
+	if err != nil {
+		return nil, err
 	}
-	
-	fmt.Printf("Found %d missing files. Downloading...\n", len(missingFiles))
-	
-	// Download missing files
-	for i, url := range missingFiles {
-		filename := extractFilenameFromURL(url)
-		fmt.Printf("[%d/%d] Downloading %s...\n", i+1, len(missingFiles), filename)
-		
-		if err := downloadSingleFile(url, filename); err != nil {
-			fmt.Printf("Error downloading %s: %v\n", filename, err)
-			continue
+	scoped = policy.Apply(scoped)
+	if requeued := catalog.quarantined(scoped); len(requeued) > 0 {
+		fmt.Printf("Re-queuing %d quarantined archives\n", len(requeued))
+	}
+	preflight := downloadPreflight(src, catalog, store, scoped)
+	if opts.DryRun {
+		printSyncPlan(scoped, catalog, store, zipDir)
+		preflight.Print(os.Stdout)
+		return &SyncReport{}, nil
+	}
+	if !opts.SkipPreflight {
+		if err := preflight.Check(); err != nil {
+			return nil, err
 		}
-		
-		fmt.Printf("✓ Successfully downloaded %s\n", filename)
 	}
-	
-	fmt.Printf("Download complete! Downloaded %d new files.\n", len(missingFiles))
-	return nil
+
+	// Every listed archive gets a request; ones we already hold are
+	// conditional on the catalog so unchanged archives are not re-sent
+	report := fetchArchives(ctx, src, catalog, store, scoped, false)
+	changes.Changed = append(changes.Changed, report.Changed...)
+
+	if err := catalog.Save(); err != nil {
+		return report, err
+	}
+
+	fmt.Printf("Download complete! Downloaded %d files (%s), %d unchanged.\n", len(report.Downloaded), formatBytes(report.Bytes), report.Unchanged)
+	changes.Print(os.Stdout)
+	report.PrintFilingChanges(os.Stdout)
+	if err := ctx.Err(); err != nil {
+		return report, fmt.Errorf("sync interrupted; run it again to finish: %w", err)
+	}
+	if report.Failed > 0 {
+		return report, fmt.Errorf("%d of %d downloads failed; run sync again to resume them", report.Failed, len(scoped))
+	}
+	return report, nil
 }
 
-// getAvailableZipFiles fetches the list of available zip files from the IRS website
-func getAvailableZipFiles() ([]string, error) {
-	res, err := http.Get("https://www.irs.gov/charities-non-profits/form-990-series-downloads")
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch IRS page: %w", err)
+// fetchArchives downloads the archives at urls into data/990_zips in store,
+// conditional on what the catalog last saw unless refetch is set or the
+// archive is quarantined, and records the results in the catalog. Filings
+// that changed inside a re-issued archive are logged and queued for
+// reprocessing. Downloads not started before ctx is done count as failed. The
+// caller saves the catalog.
+func fetchArchives(ctx context.Context, src Source, catalog *Catalog, store Storage, urls []string, refetch bool) *SyncReport {
+	zipDir := "./data/990_zips"
+	var jobs []DownloadJob
+	for _, url := range urls {
+		job := catalog.Job(url, store, storageKey(zipDir, extractFilenameFromURL(url)))
+		if refetch || len(catalog.quarantined([]string{url})) > 0 {
+			job = DownloadJob{URL: url}
+		}
+		jobs = append(jobs, job)
 	}
-	defer res.Body.Close()
 
-	doc, err := html.Parse(res.Body)
+	fmt.Printf("Checking %d files with %d workers...\n", len(jobs), defaultDownloadWorkers)
+
+	// Interrupted transfers are resumed on the next run when on local disk
+	downloader := NewDownloader(src, zipDir, defaultDownloadWorkers)
+	downloader.Verify = verifyDownload
+	if !onDisk(store) {
+		fmt.Printf("Storing archives in %s\n", store.Name())
+		downloader.Store = store
+		downloader.VerifyObject = verifyStoredDownload
+	}
+	results := downloader.DownloadContext(ctx, jobs)
+
+	queue, err := LoadReprocessQueue(reprocessPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+		fmt.Printf("Error: %v; filing changes will not be queued\n", err)
+		queue = &ReprocessQueue{path: reprocessPath, Filings: make(map[string]*PendingFiling)}
 	}
 
-	var links []string
-	var walk func(*html.Node)
-	walk = func(n *html.Node) {
-		if n.Type == html.ElementNode && n.Data == "a" {
-			for _, attr := range n.Attr {
-				if attr.Key == "href" && strings.Contains(attr.Val, ".zip") {
-					links = append(links, attr.Val)
-				}
+	report := &SyncReport{}
+	for _, result := range results {
+		if result.Err != nil {
+			report.Failed++
+			if _, err := store.Stat(storageKey(quarantineDir, filepath.Base(result.Path))); err == nil {
+				catalog.MarkQuarantined(filepath.Base(result.Path), result.URL)
+				report.Quarantined = append(report.Quarantined, filepath.Base(result.Path))
 			}
+			continue
 		}
-		for c := n.FirstChild; c != nil; c = c.NextSibling {
-			walk(c)
+
+		changed, err := catalog.Record(store, result)
+		if err != nil {
+			fmt.Printf("Error cataloging %s: %v\n", result.Path, err)
+		}
+		if changed {
+			report.Changed = append(report.Changed, filepath.Base(result.Path))
 		}
-	}
-	walk(doc)
-	
-	return links, nil
-}
 
-// getDownloadedZipFiles gets the list of already downloaded zip files
-func getDownloadedZipFiles() ([]string, error) {
-	zipDir := "./data/990_zips"
-	
-	// Ensure directory exists
-	if err := os.MkdirAll(zipDir, 0755); err != nil {
-		return nil, fmt.Errorf("failed to create directory: %w", err)
-	}
-	
-	entries, err := os.ReadDir(zipDir)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read directory: %w", err)
+		// Unchanged archives only get listed the first time they are seen
+		key := filepath.ToSlash(result.Path)
+		if entry := catalog.Entries[filepath.Base(result.Path)]; entry != nil && entry.SHA256 != "" {
+			filings, err := trackFilingChanges(store, queue, key, entry.SHA256)
+			if err != nil {
+				fmt.Printf("Error listing filings of %s: %v\n", result.Path, err)
+			}
+			if filings != nil {
+				report.FilingChanges = append(report.FilingChanges, *filings)
+			}
+		}
+		if result.NotModified {
+			report.Unchanged++
+			continue
+		}
+		if err := releaseQuarantine(store, filepath.Base(result.Path)); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		report.Downloaded = append(report.Downloaded, filepath.Base(result.Path))
+		report.Bytes += result.Bytes
 	}
-	
-	var files []string
-	for _, entry := range entries {
-		if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
-			files = append(files, entry.Name())
+
+	if len(report.FilingChanges) > 0 {
+		if err := queue.Save(); err != nil {
+			fmt.Printf("Error: %v\n", err)
 		}
 	}
-	
-	return files, nil
+	return report
 }
 
-// findMissingFiles compares available and downloaded files to find what's missing
-func findMissingFiles(availableURLs, downloadedFiles []string) []string {
-	// Create a map of downloaded filenames for quick lookup
-	downloadedMap := make(map[string]bool)
-	for _, file := range downloadedFiles {
-		downloadedMap[file] = true
+// PrintFilingChanges summarizes the filings that changed inside re-issued archives
+func (r *SyncReport) PrintFilingChanges(w io.Writer) {
+	if len(r.FilingChanges) == 0 {
+		return
 	}
-	
-	var missing []string
-	for _, url := range availableURLs {
-		filename := extractFilenameFromURL(url)
-		if !downloadedMap[filename] {
-			missing = append(missing, url)
+	fmt.Fprintf(w, "Filings changed in %d re-issued archives (logged to %s):\n", len(r.FilingChanges), filingChangeLog)
+	for _, changes := range r.FilingChanges {
+		changes.Print(w)
+	}
+}
+
+// printSyncPlan lists the archives a sync would check and what it knows about them
+func printSyncPlan(urls []string, catalog *Catalog, store Storage, zipDir string) {
+	var missing int
+	for _, url := range urls {
+		file := extractFilenameFromURL(url)
+		status := "missing"
+		if info, err := store.Stat(storageKey(zipDir, file)); err == nil {
+			status = "stored, " + formatBytes(info.Size)
+		} else if _, err := store.Stat(storageKey(quarantineDir, file)); err == nil {
+			status = "quarantined"
+			missing++
+		} else {
+			missing++
 		}
+		if entry, ok := catalog.Entries[file]; ok && entry.SHA256 != "" {
+			status += ", cataloged " + entry.DownloadedAt.Format("2006-01-02")
+		}
+		fmt.Printf("  %s (%s)\n", file, status)
 	}
-	
-	return missing
+	fmt.Printf("Dry run: %d archives in scope, %d to download, the rest checked for changes.\n", len(urls), missing)
+}
+
+// getAvailableZipFiles fetches the list of available zip files from the download page
+func getAvailableZipFiles(src Source) ([]string, error) {
+	return pageLinks(src, irsDownloadsPage, ".zip")
 }
 
 // extractFilenameFromURL extracts the filename from a URL
@@ -400,50 +325,3 @@ func extractFilenameFromURL(url string) string {
 	}
 	return ""
 }
-
-// downloadSingleFile downloads a single file with proper error handling and progress
-func downloadSingleFile(url, filename string) error {
-	// Create the data directory if it doesn't exist
-	zipDir := "./data/990_zips"
-	if err := os.MkdirAll(zipDir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory: %w", err)
-	}
-	
-	filePath := filepath.Join(zipDir, filename)
-	
-	// Check if file already exists and has size > 0
-	if info, err := os.Stat(filePath); err == nil && info.Size() > 0 {
-		return fmt.Errorf("file already exists and has content")
-	}
-	
-	// Download the file
-	res, err := http.Get(url)
-	if err != nil {
-		return fmt.Errorf("failed to download file: %w", err)
-	}
-	defer res.Body.Close()
-	
-	if res.StatusCode != http.StatusOK {
-		return fmt.Errorf("HTTP error: %d", res.StatusCode)
-	}
-	
-	// Create the output file
-	out, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("failed to create file: %w", err)
-	}
-	defer out.Close()
-	
-	// Copy the content with progress tracking
-	written, err := io.Copy(out, res.Body)
-	if err != nil {
-		return fmt.Errorf("failed to write file: %w", err)
-	}
-	
-	// Verify the file has content
-	if written == 0 {
-		return fmt.Errorf("downloaded file is empty")
-	}
-	
-	return nil
-}
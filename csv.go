@@ -1,12 +1,15 @@
 package main
 
 import (
+	"archive/zip"
+	"bytes"
 	"encoding/csv"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"runtime"
 	"strings"
@@ -16,23 +19,60 @@ import (
 
 // XMLToCSVProcessor handles converting XML files to CSV format
 type XMLToCSVProcessor struct {
-	outputFile *os.File
+	outputFile io.WriteCloser
 	csvWriter  *csv.Writer
 	fieldMap   map[string]int
 	header     []string
+	joins      []EINJoin
+	joinStart  int
 	mu         sync.Mutex
 	processed  atomic.Int64
 }
 
-// NewXMLToCSVProcessor creates a new processor
-func NewXMLToCSVProcessor(outputPath string) (*XMLToCSVProcessor, error) {
+// NewXMLToCSVProcessor creates a new processor. Each join appends its columns
+// after the filing fields, looked up by the filer's EIN.
+func NewXMLToCSVProcessor(outputPath string, joins ...EINJoin) (*XMLToCSVProcessor, error) {
 	file, err := os.Create(outputPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create output file: %w", err)
 	}
+	return NewXMLToCSVWriter(file, joins...)
+}
 
+// NewXMLToCSVWriter creates a processor that streams its CSV to file, such
+// as an object being written to a Storage. Close closes file; Abort discards
+// it when it is a stored object.
+func NewXMLToCSVWriter(file io.WriteCloser, joins ...EINJoin) (*XMLToCSVProcessor, error) {
 	writer := csv.NewWriter(file)
 	
+	header, joinStart := csvHeader(joins...)
+
+	// Create field map for quick lookup
+	fieldMap := make(map[string]int)
+	for i, field := range header {
+		fieldMap[field] = i
+	}
+
+	// Write header
+	if err := writer.Write(header); err != nil {
+		abortWriter(file)
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	writer.Flush()
+
+	return &XMLToCSVProcessor{
+		outputFile: file,
+		csvWriter:  writer,
+		fieldMap:   fieldMap,
+		header:     header,
+		joins:      joins,
+		joinStart:  joinStart,
+	}, nil
+}
+
+// csvHeader returns the CSV columns written for the joins and the index of
+// the first joined column
+func csvHeader(joins ...EINJoin) ([]string, int) {
 	// Initialize with common IRS 990 fields
 	header := []string{
 		"FileName",
@@ -176,35 +216,36 @@ func NewXMLToCSVProcessor(outputPath string) (*XMLToCSVProcessor, error) {
 		"ScheduleO",
 		"ScheduleR",
 		"AdditionalData",
+		// Columns added since are appended so existing ones keep their
+		// position
+		"Archive",
+		"ArchivePath",
+		"PrincipalOfficer",
+		"GrossReceiptsUnderLimit",
 	}
-
-	// Create field map for quick lookup
-	fieldMap := make(map[string]int)
-	for i, field := range header {
-		fieldMap[field] = i
-	}
-
-	// Write header
-	if err := writer.Write(header); err != nil {
-		file.Close()
-		return nil, fmt.Errorf("failed to write header: %w", err)
+	joinStart := len(header)
+	for _, join := range joins {
+		header = append(header, join.Columns()...)
 	}
-	writer.Flush()
-
-	return &XMLToCSVProcessor{
-		outputFile: file,
-		csvWriter:  writer,
-		fieldMap:   fieldMap,
-		header:     header,
-	}, nil
+	return header, joinStart
 }
 
 // Close closes the processor and flushes data
 func (p *XMLToCSVProcessor) Close() error {
 	p.csvWriter.Flush()
+	if err := p.csvWriter.Error(); err != nil {
+		abortWriter(p.outputFile)
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
 	return p.outputFile.Close()
 }
 
+// Abort stops the processor without publishing a stored output, leaving the
+// previous copy in place
+func (p *XMLToCSVProcessor) Abort() error {
+	return abortWriter(p.outputFile)
+}
+
 // ProcessDirectory processes all XML files in a directory
 func (p *XMLToCSVProcessor) ProcessDirectory(dirPath string) error {
 	entries, err := os.ReadDir(dirPath)
@@ -212,9 +253,7 @@ func (p *XMLToCSVProcessor) ProcessDirectory(dirPath string) error {
 		return fmt.Errorf("failed to read directory %s: %w", dirPath, err)
 	}
 
-	var wg sync.WaitGroup
-	semaphore := make(chan struct{}, runtime.NumCPU()*2) // Limit concurrent processing
-
+	var paths []string
 	for _, entry := range entries {
 		if entry.IsDir() {
 			continue
@@ -224,8 +263,19 @@ func (p *XMLToCSVProcessor) ProcessDirectory(dirPath string) error {
 			continue
 		}
 
-		filePath := filepath.Join(dirPath, entry.Name())
-		
+		paths = append(paths, filepath.Join(dirPath, entry.Name()))
+	}
+
+	p.ProcessFiles(paths)
+	return nil
+}
+
+// ProcessFiles processes the given XML files concurrently
+func (p *XMLToCSVProcessor) ProcessFiles(paths []string) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, runtime.NumCPU()*2) // Limit concurrent processing
+
+	for _, filePath := range paths {
 		wg.Add(1)
 		go func(path string) {
 			defer wg.Done()
@@ -239,10 +289,49 @@ func (p *XMLToCSVProcessor) ProcessDirectory(dirPath string) error {
 	}
 
 	wg.Wait()
+}
+
+// ProcessArchive processes the XML entries of a zip archive without extracting
+// it. When only is non-nil just the named entries are processed.
+func (p *XMLToCSVProcessor) ProcessArchive(zipPath string, only map[string]bool) error {
+	archive := filepath.Base(zipPath)
+	return forEachZipXML(zipPath, runtime.NumCPU()*2, only, func(entry *zip.File) {
+		if err := p.processZipEntry(archive, entry); err != nil {
+			log.Printf("Error processing %s in %s: %v", entry.Name, archive, err)
+		}
+	})
+}
+
+// ProcessStoredArchive processes the XML entries of an archive held in a
+// Storage, reading it in place. When only is non-nil just the named entries
+// are processed.
+func (p *XMLToCSVProcessor) ProcessStoredArchive(store Storage, key string, only map[string]bool) error {
+	reader, closer, err := openZip(store, key)
+	if err != nil {
+		return err
+	}
+	defer closer.Close()
+
+	archive := path.Base(key)
+	forEachZipEntry(reader, runtime.NumCPU()*2, only, func(entry *zip.File) {
+		if err := p.processZipEntry(archive, entry); err != nil {
+			log.Printf("Error processing %s in %s: %v", entry.Name, archive, err)
+		}
+	})
 	return nil
 }
 
-// processXMLFile processes a single XML file
+// ProcessModel processes a filing held in generated model structs. It is
+// marshalled to XML so its fields are mapped exactly like archived filings.
+func (p *XMLToCSVProcessor) ProcessModel(filing any, archive, entryPath string) error {
+	data, err := xml.Marshal(filing)
+	if err != nil {
+		return fmt.Errorf("failed to marshal filing: %w", err)
+	}
+	return p.processXML(bytes.NewReader(data), archive, entryPath)
+}
+
+// processXMLFile processes a single extracted XML file
 func (p *XMLToCSVProcessor) processXMLFile(filePath string) error {
 	file, err := os.Open(filePath)
 	if err != nil {
@@ -250,21 +339,47 @@ func (p *XMLToCSVProcessor) processXMLFile(filePath string) error {
 	}
 	defer file.Close()
 
+	archive, entry := archiveProvenance(zipBaseDir, filePath)
+	return p.processXML(file, archive, entry)
+}
+
+// processZipEntry processes a single XML entry read straight from an archive
+func (p *XMLToCSVProcessor) processZipEntry(archive string, entry *zip.File) error {
+	rc, err := entry.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open entry: %w", err)
+	}
+	defer rc.Close()
+
+	return p.processXML(rc, archive, entry.Name)
+}
+
+// processXML converts one filing into a CSV record, recording the archive and
+// entry path it was read from
+func (p *XMLToCSVProcessor) processXML(r io.Reader, archive, entryPath string) error {
 	// Initialize record with empty strings
 	record := make([]string, len(p.header))
 	for i := range record {
 		record[i] = ""
 	}
 
-	// Set filename
-	record[p.fieldMap["FileName"]] = filepath.Base(filePath)
+	// Set filename and provenance
+	record[p.fieldMap["FileName"]] = path.Base(entryPath)
+	record[p.fieldMap["Archive"]] = archive
+	record[p.fieldMap["ArchivePath"]] = entryPath
 
 	// Parse XML and extract data
-	decoder := xml.NewDecoder(file)
+	decoder := xml.NewDecoder(r)
 	if err := p.extractXMLData(decoder, record); err != nil {
 		return fmt.Errorf("failed to parse XML: %w", err)
 	}
 
+	// Append the joined columns for the filer
+	column := p.joinStart
+	for _, join := range p.joins {
+		column += copy(record[column:], join.Values(record[p.fieldMap["EIN"]]))
+	}
+
 	// Write record to CSV
 	p.mu.Lock()
 	if err := p.csvWriter.Write(record); err != nil {
@@ -344,6 +459,15 @@ func (p *XMLToCSVProcessor) mapFieldToRecord(path, value string, record []string
 		"Return.ReturnHeader.ReturnTs": "FilingDate",
 		"Return.ReturnHeader.TaxPeriodBeginDt": "TaxPeriodBegin",
 		"Return.ReturnHeader.TaxPeriodEndDt": "TaxPeriodEnd",
+		"Return.ReturnHeader.Filer.ForeignAddress.AddressLine1Txt": "AddressLine1",
+		"Return.ReturnHeader.Filer.ForeignAddress.AddressLine2Txt": "AddressLine2",
+		"Return.ReturnHeader.Filer.ForeignAddress.CityNm": "City",
+		"Return.ReturnHeader.Filer.ForeignAddress.ProvinceOrStateNm": "State",
+		"Return.ReturnHeader.Filer.ForeignAddress.CountryCd": "Country",
+		"Return.ReturnHeader.Filer.ForeignAddress.ForeignPostalCd": "ZIPCode",
+		"Return.ReturnData.IRS990N.WebsiteAddressTxt": "Website",
+		"Return.ReturnData.IRS990N.PersonNm": "PrincipalOfficer",
+		"Return.ReturnData.IRS990N.GrossReceiptsLimitInd": "GrossReceiptsUnderLimit",
 		"Return.ReturnHeader.PreparerPersonGrp.PreparerPersonNm": "PreparerName",
 		"Return.ReturnHeader.PreparerFirmGrp.PreparerFirmName.BusinessNameLine1Txt": "PreparerFirm",
 		"Return.ReturnHeader.BusinessOfficerGrp.PersonNm": "SignatureName",
@@ -519,27 +643,75 @@ func (p *XMLToCSVProcessor) mapFieldToRecord(path, value string, record []string
 	}
 }
 
-// ProcessAllDirectories processes all extracted directories
-func ProcessAllDirectories() error {
-	processor, err := NewXMLToCSVProcessor("irs_990_data.csv")
+// ProcessAllDirectories processes every archive in the data directory, reading
+// XML entries straight from the zip files. Extracted directories are only read
+// when their zip file is gone. When the filter is not empty only the filings
+// it selects from the filing index are processed. Joins append columns looked
+// up by EIN, such as the EO BMF classification. Archives held in a store other
+// than the working directory are read in place and the CSV is written back to
+// that store.
+func ProcessAllDirectories(store Storage, filter FilingFilter, joins ...EINJoin) error {
+	if !onDisk(store) {
+		return processStoredArchives(store, filter, joins...)
+	}
+
+	processor, err := NewXMLToCSVProcessor("irs_990_data.csv", joins...)
 	if err != nil {
 		return fmt.Errorf("failed to create processor: %w", err)
 	}
 	defer processor.Close()
 
-	baseDir := "data/990_zips"
-	entries, err := os.ReadDir(baseDir)
+	if !filter.Empty() {
+		index, err := LoadFilingIndex(filepath.Join(indexDir, filingIndexCSV))
+		if err != nil {
+			return err
+		}
+
+		selected := index.Select(filter)
+		archives, paths := locateFilings(selected, zipBaseDir)
+		log.Printf("Index selected %d filings in %d archives and %d extracted files", len(selected), len(archives), len(paths))
+
+		for zipPath, only := range archives {
+			if err := processor.ProcessArchive(zipPath, only); err != nil {
+				log.Printf("Error processing archive %s: %v", zipPath, err)
+			}
+		}
+		processor.ProcessFiles(paths)
+		log.Printf("Processing complete. Total files processed: %d", processor.processed.Load())
+		return nil
+	}
+
+	entries, err := os.ReadDir(zipBaseDir)
 	if err != nil {
 		return fmt.Errorf("failed to read base directory: %w", err)
 	}
 
-	// Process each directory
+	zips := make(map[string]bool)
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+			zips[strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))] = true
+		}
+	}
+
+	// Process each archive, falling back to directories without a zip
 	for _, entry := range entries {
 		if !entry.IsDir() {
+			if !strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+				continue
+			}
+
+			zipPath := filepath.Join(zipBaseDir, entry.Name())
+			log.Printf("Processing archive: %s", zipPath)
+			if err := processor.ProcessArchive(zipPath, nil); err != nil {
+				log.Printf("Error processing archive %s: %v", zipPath, err)
+			}
+			continue
+		}
+		if zips[entry.Name()] {
 			continue
 		}
 
-		dirPath := filepath.Join(baseDir, entry.Name())
+		dirPath := filepath.Join(zipBaseDir, entry.Name())
 		log.Printf("Processing directory: %s", dirPath)
 		
 		if err := processor.ProcessDirectory(dirPath); err != nil {
@@ -550,4 +722,85 @@ func ProcessAllDirectories() error {
 
 	log.Printf("Processing complete. Total files processed: %d", processor.processed.Load())
 	return nil
-} 
\ No newline at end of file
+}
+
+// processStoredArchives streams irs_990_data.csv into store from the archives
+// under data/990_zips in the same store
+func processStoredArchives(store Storage, filter FilingFilter, joins ...EINJoin) error {
+	var selected map[string]map[string]bool
+	if !filter.Empty() {
+		index, err := LoadFilingIndex(filepath.Join(indexDir, filingIndexCSV))
+		if err != nil {
+			return err
+		}
+		selected = make(map[string]map[string]bool)
+		for _, record := range index.Select(filter) {
+			if record.Entry == "" {
+				continue
+			}
+			key := storageKey(zipBaseDir, record.Archive+".zip")
+			if selected[key] == nil {
+				selected[key] = make(map[string]bool)
+			}
+			selected[key][record.Entry] = true
+		}
+		log.Printf("Index selected filings in %d archives", len(selected))
+	}
+
+	objects, err := store.List(zipBaseDir + "/")
+	if err != nil {
+		return err
+	}
+
+	out, err := store.Create("irs_990_data.csv")
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+	processor, err := NewXMLToCSVWriter(out, joins...)
+	if err != nil {
+		return fmt.Errorf("failed to create processor: %w", err)
+	}
+
+	for _, object := range objects {
+		if path.Dir(object.Key) != zipBaseDir || !strings.HasSuffix(strings.ToLower(object.Key), ".zip") {
+			continue
+		}
+		var only map[string]bool
+		if selected != nil {
+			if only = selected[object.Key]; only == nil {
+				continue
+			}
+		}
+
+		log.Printf("Processing archive: %s/%s", store.Name(), object.Key)
+		if err := processor.ProcessStoredArchive(store, object.Key, only); err != nil {
+			log.Printf("Error processing archive %s: %v", object.Key, err)
+		}
+	}
+
+	log.Printf("Processing complete. Total files processed: %d", processor.processed.Load())
+	return processor.Close()
+}
+
+// locateFilings groups indexed filings by the archive that holds them, falling
+// back to the extracted copy for filings whose archive is not on disk
+func locateFilings(records []*FilingRecord, zipDir string) (map[string]map[string]bool, []string) {
+	archives := make(map[string]map[string]bool)
+	var paths []string
+	for _, record := range records {
+		if record.Entry != "" {
+			zipPath := filepath.Join(zipDir, record.Archive+".zip")
+			if _, err := os.Stat(zipPath); err == nil {
+				if archives[zipPath] == nil {
+					archives[zipPath] = make(map[string]bool)
+				}
+				archives[zipPath][record.Entry] = true
+				continue
+			}
+		}
+		if record.XMLPath != "" {
+			paths = append(paths, record.XMLPath)
+		}
+	}
+	return archives, paths
+}
\ No newline at end of file
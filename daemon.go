@@ -0,0 +1,425 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultDaemonInterval = 6 * time.Hour
+	daemonLockPath        = "./data/daemon.lock"
+	daemonRunLog          = "./data/daemon_runs.jsonl"
+	// csvArchiveDir holds one CSV per archive, written as archives arrive
+	csvArchiveDir = "./data/990_csv"
+)
+
+// DaemonOptions control RunDaemon
+type DaemonOptions struct {
+	Interval time.Duration
+	// Once runs a single cycle and exits
+	Once    bool
+	Filter  ArchiveFilter
+	Workers int
+	Joins   []EINJoin
+	// Store holds the archives and CSVs
+	Store Storage
+}
+
+// RunSummary records one daemon cycle; one is appended to the run log per cycle
+type RunSummary struct {
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+	Source      string    `json:"source"`
+	Downloaded  []string  `json:"downloaded,omitempty"`
+	Unchanged   int       `json:"unchanged"`
+	Failed      int       `json:"failed"`
+	Quarantined []string  `json:"quarantined,omitempty"`
+	Bytes       int64     `json:"bytes"`
+	Extracted   int       `json:"extracted"`
+	// FilingsChanged counts the filings added, removed or modified inside
+	// re-issued archives
+	FilingsChanged int      `json:"filings_changed"`
+	CSVs           []string `json:"csvs,omitempty"`
+	// Patched holds the CSVs updated by reprocessing only their changed filings
+	Patched []string `json:"patched,omitempty"`
+	// Reclaimed is the space the retention policy freed after the cycle
+	Reclaimed int64    `json:"reclaimed,omitempty"`
+	Errors    []string `json:"errors,omitempty"`
+}
+
+// fail records a step's error in the summary
+func (s *RunSummary) fail(step string, err error) {
+	s.Errors = append(s.Errors, fmt.Sprintf("%s: %v", step, err))
+}
+
+// Print writes a one-paragraph report of the cycle
+func (s *RunSummary) Print(w io.Writer) {
+	fmt.Fprintf(w, "Cycle %s finished in %s: %d new or changed archives (%s), %d unchanged, %d failed, %d quarantined, %d extracted, %d filings changed, %d CSVs written (%d patched)\n",
+		s.StartedAt.Format(time.RFC3339), s.FinishedAt.Sub(s.StartedAt).Round(time.Second),
+		len(s.Downloaded), formatBytes(s.Bytes), s.Unchanged, s.Failed, len(s.Quarantined), s.Extracted, s.FilingsChanged, len(s.CSVs), len(s.Patched))
+	if s.Reclaimed > 0 {
+		fmt.Fprintf(w, "  retention policy reclaimed %s\n", formatBytes(s.Reclaimed))
+	}
+	for _, e := range s.Errors {
+		fmt.Fprintf(w, "  error: %s\n", e)
+	}
+}
+
+// appendRunSummary adds the summary as one JSON line to the run log
+func appendRunSummary(path string, s *RunSummary) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to encode run summary: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open run log: %w", err)
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write run log: %w", err)
+	}
+	return file.Close()
+}
+
+// acquireLock creates the lockfile holding our PID. A lockfile left by a
+// process that is no longer running is taken over.
+func acquireLock(path string) (release func(), err error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create lock directory: %w", err)
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			fmt.Fprintf(file, "%d\n", os.Getpid())
+			file.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("failed to create lockfile: %w", err)
+		}
+
+		pid, alive := lockOwner(path)
+		if alive {
+			return nil, fmt.Errorf("another daemon (pid %d) holds %s", pid, path)
+		}
+		fmt.Printf("Removing stale lockfile %s left by pid %d\n", path, pid)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("failed to remove stale lockfile: %w", err)
+		}
+	}
+	return nil, fmt.Errorf("failed to acquire %s", path)
+}
+
+// lockOwner reads the PID in a lockfile and reports whether it is still running
+func lockOwner(path string) (int, bool) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false
+	}
+	pid, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil || pid <= 0 {
+		return 0, false
+	}
+	process, err := os.FindProcess(pid)
+	if err != nil {
+		return pid, false
+	}
+	return pid, process.Signal(syscall.Signal(0)) == nil
+}
+
+// RunDaemon runs the pipeline every interval until interrupted: sync new and
+// changed archives, which are verified as they are downloaded, extract them
+// and write a CSV for each. Archives whose CSV is current are not touched
+// again, and a summary of each cycle is appended to the run log.
+func RunDaemon(src Source, opts DaemonOptions) error {
+	if opts.Store == nil {
+		opts.Store = &LocalStorage{Root: "."}
+	}
+	release, err := acquireLock(daemonLockPath)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	for {
+		summary := runCycle(ctx, src, opts)
+		if ctx.Err() == nil {
+			applyRetention(opts.Store, summary)
+		}
+		summary.Print(os.Stdout)
+		if err := appendRunSummary(daemonRunLog, summary); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		if ctx.Err() != nil {
+			fmt.Println("Daemon stopped")
+			return nil
+		}
+		if opts.Once {
+			return nil
+		}
+
+		fmt.Printf("Next cycle at %s\n", time.Now().Add(opts.Interval).Format(time.RFC3339))
+		select {
+		case <-ctx.Done():
+			fmt.Println("Daemon stopped")
+			return nil
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
+// runCycle runs the pipeline once for whatever is new upstream. When ctx is
+// done it stops before the next stage or archive; whatever is left is picked
+// up by the next cycle.
+func runCycle(ctx context.Context, src Source, opts DaemonOptions) *RunSummary {
+	summary := &RunSummary{StartedAt: time.Now().UTC(), Source: src.Name()}
+	defer func() { summary.FinishedAt = time.Now().UTC() }()
+
+	// A failed download is retried next cycle, so it doesn't stop the rest
+	report, err := CheckAndDownloadMissingZips(ctx, src, SyncOptions{Filter: opts.Filter, Store: opts.Store})
+	if err != nil {
+		summary.fail("sync", err)
+	}
+	if report == nil {
+		return summary
+	}
+	summary.Downloaded = report.Downloaded
+	summary.Unchanged = report.Unchanged
+	summary.Failed = report.Failed
+	summary.Quarantined = report.Quarantined
+	summary.Bytes = report.Bytes
+	listed := make(map[string]bool)
+	for _, changes := range report.FilingChanges {
+		listed[changes.Archive] = true
+		summary.FilingsChanged += len(changes.Added) + len(changes.Removed) + len(changes.Modified)
+	}
+
+	pending, err := pendingArchives(opts.Store, report.Downloaded, opts.Filter)
+	if err != nil {
+		summary.fail("scan", err)
+	}
+	if len(pending) == 0 || ctx.Err() != nil {
+		return summary
+	}
+
+	// Archives whose extraction is current are skipped and not counted
+	extracted, err := ExtractAllZips(ctx, ExtractOptions{Workers: opts.Workers, Archives: pending, Store: opts.Store})
+	summary.Extracted = extracted
+	if err != nil {
+		summary.fail("extract", err)
+	}
+	if ctx.Err() != nil {
+		return summary
+	}
+
+	queue, err := LoadReprocessQueue(reprocessPath)
+	if err != nil {
+		summary.fail("csv", err)
+		return summary
+	}
+	for _, name := range pending {
+		if err := ctx.Err(); err != nil {
+			summary.fail("csv", err)
+			break
+		}
+		key, patched, err := updateArchiveCSV(opts.Store, name, opts.Joins, queue, listed[name])
+		if err != nil {
+			summary.fail("csv", err)
+			continue
+		}
+		summary.CSVs = append(summary.CSVs, key)
+		if patched {
+			summary.Patched = append(summary.Patched, key)
+		}
+	}
+	if err := queue.Save(); err != nil {
+		summary.fail("csv", err)
+	}
+	return summary
+}
+
+// applyRetention runs the retention policy after a cycle. The daemon already
+// holds the lock gc would take.
+func applyRetention(store Storage, summary *RunSummary) {
+	policy, err := LoadRetentionPolicy(retentionPath)
+	if err != nil {
+		summary.fail("gc", err)
+		return
+	}
+	plan, err := PlanGC(store, policy)
+	if err != nil {
+		summary.fail("gc", err)
+		return
+	}
+	reclaimed, err := plan.Apply(store)
+	summary.Reclaimed = reclaimed
+	if err != nil {
+		summary.fail("gc", err)
+	}
+}
+
+// pendingArchives returns the archives just downloaded plus any in scope whose
+// CSV is missing or older than the archive, so a cycle that failed part way
+// is finished by the next one
+func pendingArchives(store Storage, downloaded []string, filter ArchiveFilter) ([]string, error) {
+	pending := append([]string(nil), downloaded...)
+	seen := make(map[string]bool)
+	for _, name := range downloaded {
+		seen[name] = true
+	}
+
+	archives, err := listArchives(store)
+	if err != nil {
+		return pending, err
+	}
+	for _, name := range archives {
+		if seen[name] || !filter.Match(name) {
+			continue
+		}
+		info, err := store.Stat(storageKey(zipBaseDir, name))
+		if err != nil {
+			continue
+		}
+		csvInfo, err := store.Stat(archiveCSVKey(name))
+		if err != nil || csvInfo.ModTime.Before(info.ModTime) {
+			pending = append(pending, name)
+		}
+	}
+	return pending, nil
+}
+
+// archiveCSVKey is where writeArchiveCSV puts an archive's filings
+func archiveCSVKey(archive string) string {
+	return storageKey(csvArchiveDir, strings.TrimSuffix(archive, path.Ext(archive))+".csv")
+}
+
+// errCSVLayout means an existing CSV has other columns than would be written now
+var errCSVLayout = errors.New("CSV columns differ from the current layout")
+
+// updateArchiveCSV brings the CSV of an archive up to date. When the filings
+// that changed in it are known, listed because the previous copy's listing
+// was compared or queued by an earlier cycle, only those are processed again;
+// otherwise the CSV is rewritten in full. It reports whether it patched.
+func updateArchiveCSV(store Storage, archive string, joins []EINJoin, queue *ReprocessQueue, listed bool) (string, bool, error) {
+	stale, reprocess := queue.Pending(archive)
+	if listed || len(stale) > 0 {
+		key, err := patchArchiveCSV(store, archive, joins, stale, reprocess)
+		if err == nil {
+			queue.Clear(archive)
+			return key, true, nil
+		}
+		if !errors.Is(err, errCSVLayout) && !errors.Is(err, fs.ErrNotExist) {
+			return "", false, err
+		}
+	}
+
+	key, err := writeArchiveCSV(store, archive, joins)
+	if err != nil {
+		return "", false, err
+	}
+	queue.Clear(archive)
+	return key, false, nil
+}
+
+// patchArchiveCSV rewrites the CSV of an archive, copying the rows of every
+// filing not in stale from the current CSV and processing the entries in
+// reprocess again
+func patchArchiveCSV(store Storage, archive string, joins []EINJoin, stale, reprocess map[string]bool) (string, error) {
+	key := archiveCSVKey(archive)
+	current, err := store.Open(key)
+	if err != nil {
+		return "", err
+	}
+	defer current.Close()
+
+	reader := csv.NewReader(current)
+	reader.ReuseRecord = true
+	header, err := reader.Read()
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	if columns, _ := csvHeader(joins...); !slices.Equal(header, columns) {
+		return "", errCSVLayout
+	}
+
+	out, err := store.Create(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", key, err)
+	}
+	processor, err := NewXMLToCSVWriter(out, joins...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create processor: %w", err)
+	}
+
+	entry := processor.fieldMap["ArchivePath"]
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			processor.Abort()
+			return "", fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		if stale[row[entry]] {
+			continue
+		}
+		if err := processor.csvWriter.Write(row); err != nil {
+			processor.Abort()
+			return "", fmt.Errorf("failed to write %s: %w", key, err)
+		}
+	}
+
+	if err := processor.ProcessStoredArchive(store, storageKey(zipBaseDir, archive), reprocess); err != nil {
+		processor.Abort()
+		return "", fmt.Errorf("failed to process %s: %w", archive, err)
+	}
+	if err := processor.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	return key, nil
+}
+
+// writeArchiveCSV writes the filings in one archive to their own CSV in
+// csvArchiveDir, replacing the CSV of an earlier copy of the archive once the
+// new one is complete
+func writeArchiveCSV(store Storage, archive string, joins []EINJoin) (string, error) {
+	key := archiveCSVKey(archive)
+	out, err := store.Create(key)
+	if err != nil {
+		return "", fmt.Errorf("failed to create %s: %w", key, err)
+	}
+
+	processor, err := NewXMLToCSVWriter(out, joins...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create processor: %w", err)
+	}
+	if err := processor.ProcessStoredArchive(store, storageKey(zipBaseDir, archive), nil); err != nil {
+		processor.Abort()
+		return "", fmt.Errorf("failed to process %s: %w", archive, err)
+	}
+	if err := processor.Close(); err != nil {
+		return "", fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	return key, nil
+}
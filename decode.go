@@ -0,0 +1,277 @@
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/synergos-systems/models/versions"
+	"github.com/synergos-systems/xsd"
+)
+
+// DecodedFiling is a filing decoded into the models of its schema version
+type DecodedFiling struct {
+	// ReturnVersion is the returnVersion attribute of the filing
+	ReturnVersion string
+	// ModelVersion is the version whose models decoded the filing. It differs
+	// from ReturnVersion when no models were generated for that version.
+	ModelVersion string
+	// ModelFamily is the form family of those models. It differs from the
+	// filing's when that family has no models of its own.
+	ModelFamily string
+	ReturnType  string
+	Header       any
+	Documents    []DecodedDocument
+	// Unknown lists the elements the models have no type for; they are skipped
+	Unknown []string
+}
+
+// DecodedDocument is one document in the ReturnData of a filing
+type DecodedDocument struct {
+	Name  string
+	Model any
+}
+
+// modelVersion is one generated version with its version number parsed
+type modelVersion struct {
+	versions.Version
+	year, major, minor int
+}
+
+var (
+	// modelVersions lists the generated versions, newest first
+	modelVersions = sync.OnceValue(func() []*modelVersion {
+		var list []*modelVersion
+		for _, v := range versions.All {
+			year, major, minor, err := ParseSchemaVersion(v.Version)
+			if err != nil {
+				continue
+			}
+			list = append(list, &modelVersion{Version: v, year: year, major: major, minor: minor})
+		}
+		sort.SliceStable(list, func(i, j int) bool { return list[i].newer(list[j].year, list[j].major, list[j].minor) })
+		return list
+	})
+	// fallbackWarned holds the fallbacks already warned about, so a run over
+	// thousands of filings warns once per version
+	fallbackWarned sync.Map
+)
+
+// newer reports whether v is a later release than the given version
+func (v *modelVersion) newer(year, major, minor int) bool {
+	if v.year != year {
+		return v.year > year
+	}
+	if v.major != major {
+		return v.major > major
+	}
+	return v.minor > minor
+}
+
+// modelVersionFor picks the models to decode a filing of a form family with.
+// Without models for its exact version, the nearest revision of the same
+// schema year is used, an older one first; failing that the nearest version
+// of another year, an older one on a tie. Families without models of their
+// own, such as 990EZ, use the 990 series'. It reports whether the models are
+// the family's own for exactly that version.
+func modelVersionFor(returnVersion, family string) (*modelVersion, bool, error) {
+	year, major, minor, err := ParseSchemaVersion(returnVersion)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var candidates []*modelVersion
+	own := true
+	for _, f := range []string{family, defaultSchemaFamily} {
+		for _, v := range modelVersions() {
+			if strings.EqualFold(v.Family, f) {
+				candidates = append(candidates, v)
+			}
+		}
+		if len(candidates) > 0 {
+			break
+		}
+		own = false
+	}
+	if len(candidates) == 0 {
+		return nil, false, errors.New("no models have been generated; run the schemas command")
+	}
+
+	// candidates are newest first, so the last newer one and the first older one are the nearest
+	var older, newer *modelVersion
+	for _, v := range candidates {
+		switch {
+		case v.newer(year, major, minor):
+			newer = v
+		case v.year == year && v.major == major && v.minor == minor:
+			return v, own, nil
+		case older == nil:
+			older = v
+		}
+	}
+
+	switch {
+	case older != nil && older.year == year:
+		return older, false, nil
+	case newer != nil && newer.year == year:
+		return newer, false, nil
+	case older != nil && (newer == nil || year-older.year <= newer.year-year):
+		return older, false, nil
+	default:
+		return newer, false, nil
+	}
+}
+
+// model makes a new model for a top-level element. When several documents
+// declare the element, the one named after it is used, then the one named
+// after it and the return type as in ReturnHeader990N, then the one the 990
+// series shares as in ReturnHeader990x.
+func (v *modelVersion) model(element, returnType string) any {
+	for _, document := range []string{element, element + returnType, element + "990x"} {
+		if newModel, ok := v.Documents[document][element]; ok {
+			return newModel()
+		}
+	}
+
+	var documents []string
+	for document, elements := range v.Documents {
+		if _, ok := elements[element]; ok {
+			documents = append(documents, document)
+		}
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+	sort.Strings(documents)
+	return v.Documents[documents[0]][element]()
+}
+
+// DecodeFiling decodes a filing into the generated models of the schema
+// version named by the returnVersion attribute of its Return element: the
+// ReturnHeader and each document in ReturnData. A filing whose version has no
+// models, or whose family has none, is decoded with the nearest models there
+// are, with a warning.
+func DecodeFiling(r io.Reader) (*DecodedFiling, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filing: %w", err)
+	}
+
+	var envelope struct {
+		XMLName       xml.Name
+		ReturnVersion string `xml:"returnVersion,attr"`
+		ReturnTypeCd  string `xml:"ReturnHeader>ReturnTypeCd"`
+	}
+	if err := xml.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse filing: %w", err)
+	}
+	if envelope.XMLName.Local != "Return" {
+		return nil, fmt.Errorf("filing is a %s element, not a Return", envelope.XMLName.Local)
+	}
+	if envelope.ReturnVersion == "" {
+		return nil, errors.New("filing has no returnVersion attribute")
+	}
+
+	family := schemaFamily(envelope.ReturnTypeCd)
+	version, exact, err := modelVersionFor(envelope.ReturnVersion, family)
+	if err != nil {
+		return nil, err
+	}
+	if !exact {
+		key := envelope.ReturnVersion + " " + family
+		if _, warned := fallbackWarned.LoadOrStore(key, true); !warned {
+			fmt.Printf("Warning: no models for returnVersion %s (%s); decoding with the nearest, %s (%s)\n", envelope.ReturnVersion, family, version.Version.Version, version.Family)
+		}
+	}
+
+	filing := &DecodedFiling{
+		ReturnVersion: envelope.ReturnVersion,
+		ModelVersion:  version.Version.Version,
+		ModelFamily:   version.Family,
+		ReturnType:    envelope.ReturnTypeCd,
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	var path []string
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse filing: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			parent := strings.Join(path, ".")
+			header := parent == "Return" && t.Name.Local == "ReturnHeader"
+			if !header && parent != "Return.ReturnData" {
+				path = append(path, t.Name.Local)
+				continue
+			}
+
+			model := version.model(t.Name.Local, envelope.ReturnTypeCd)
+			if model == nil {
+				filing.Unknown = append(filing.Unknown, t.Name.Local)
+				if err := decoder.Skip(); err != nil {
+					return nil, fmt.Errorf("failed to parse filing: %w", err)
+				}
+				continue
+			}
+			if err := decoder.DecodeElement(model, &t); err != nil {
+				return nil, fmt.Errorf("failed to decode %s: %w", t.Name.Local, err)
+			}
+			if header {
+				filing.Header = model
+			} else {
+				filing.Documents = append(filing.Documents, DecodedDocument{Name: t.Name.Local, Model: model})
+			}
+
+		case xml.EndElement:
+			if len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+		}
+	}
+	return filing, nil
+}
+
+// Validate checks the decoded header and documents against the facets and
+// required elements of their schemas, returning every violation with its
+// element path
+func (f *DecodedFiling) Validate() error {
+	var errs xsd.Errors
+	if header, ok := f.Header.(xsd.Validator); ok {
+		errs.Add("ReturnHeader", header.Validate())
+	}
+	for _, document := range f.Documents {
+		if model, ok := document.Model.(xsd.Validator); ok {
+			errs.Add("ReturnData/"+document.Name, model.Validate())
+		}
+	}
+	return errs.Err()
+}
+
+// Print summarizes what the filing decoded into
+func (f *DecodedFiling) Print(w io.Writer) {
+	fmt.Fprintf(w, "Return type %s, returnVersion %s", f.ReturnType, f.ReturnVersion)
+	if f.ModelVersion != f.ReturnVersion || !strings.EqualFold(f.ModelFamily, schemaFamily(f.ReturnType)) {
+		fmt.Fprintf(w, " (decoded with %s %s)", f.ModelFamily, f.ModelVersion)
+	}
+	fmt.Fprintln(w)
+	if f.Header != nil {
+		fmt.Fprintf(w, "  ReturnHeader: %T\n", f.Header)
+	}
+	for _, document := range f.Documents {
+		fmt.Fprintf(w, "  %s: %T\n", document.Name, document.Model)
+	}
+	if len(f.Unknown) > 0 {
+		fmt.Fprintf(w, "No models for %s\n", strings.Join(f.Unknown, ", "))
+	}
+}
@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestModelVersionFor(t *testing.T) {
+	tests := []struct {
+		name          string
+		returnVersion string
+		family        string
+		want          string
+		exact         bool
+	}{
+		{name: "own version", returnVersion: "2023v4.0", family: "990", want: "2023v4.0", exact: true},
+		{name: "nearest version", returnVersion: "2022v5.0", family: "990", want: "2023v4.0"},
+		{name: "family without models", returnVersion: "2023v4.0", family: "990EZ", want: "2023v4.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, exact, err := modelVersionFor(tt.returnVersion, tt.family)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version.Version.Version != tt.want || exact != tt.exact {
+				t.Errorf("modelVersionFor(%q, %q) = %s, %v, want %s, %v", tt.returnVersion, tt.family, version.Version.Version, exact, tt.want, tt.exact)
+			}
+		})
+	}
+}
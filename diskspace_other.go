@@ -0,0 +1,8 @@
+//go:build !unix
+
+package main
+
+// diskFree is not implemented on this platform, so preflight checks are skipped
+func diskFree(dir string) (uint64, error) {
+	return 0, errNoDiskFree
+}
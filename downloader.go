@@ -0,0 +1,527 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultDownloadWorkers = 4
+	partSuffix             = ".part"
+	// validatorSuffix names the file next to a ".part" file that holds the
+	// ETag or Last-Modified of the version its bytes came from
+	validatorSuffix  = ".validator"
+	progressInterval = 5 * time.Second
+)
+
+// Downloader fetches files into a directory using a bounded pool of workers.
+// Each transfer is staged in a ".part" file and only renamed to its final
+// name once complete, so an interrupted run can resume with a Range request
+// while the file upstream is unchanged.
+type Downloader struct {
+	Dir      string
+	Workers  int
+	Source   Source
+	Interval time.Duration
+	Out      io.Writer
+	// Verify, when set, checks each completed file before it is renamed
+	// into place; files that fail are moved to quarantine
+	Verify func(path string) error
+	// Store, when set, receives the files under Dir instead of local disk,
+	// and VerifyObject plays the part of Verify for them
+	Store        Storage
+	VerifyObject func(store Storage, key string) error
+}
+
+// DownloadJob is a single file for the Downloader to fetch
+type DownloadJob struct {
+	URL string
+	// ETag and LastModified come from a previous download and make the
+	// request conditional, so unchanged files are not fetched again
+	ETag         string
+	LastModified string
+}
+
+// DownloadResult describes the outcome of a single transfer
+type DownloadResult struct {
+	URL          string
+	Path         string
+	Bytes        int64
+	SHA256       string
+	ETag         string
+	LastModified string
+	Resumed      bool
+	NotModified  bool
+	Err          error
+}
+
+// transfer tracks the progress of one file while it is being downloaded
+type transfer struct {
+	job      DownloadJob
+	filename string
+	size     atomic.Int64
+	written  atomic.Int64
+	active   atomic.Bool
+}
+
+// progressWriter counts bytes as they are written to a transfer
+type progressWriter struct {
+	t     *transfer
+	total *atomic.Int64
+}
+
+func (w progressWriter) Write(p []byte) (int, error) {
+	w.t.written.Add(int64(len(p)))
+	w.total.Add(int64(len(p)))
+	return len(p), nil
+}
+
+// NewDownloader creates a downloader that fetches from src and writes into dir
+func NewDownloader(src Source, dir string, workers int) *Downloader {
+	if workers < 1 {
+		workers = 1
+	}
+	return &Downloader{
+		Dir:      dir,
+		Workers:  workers,
+		Source:   src,
+		Interval: progressInterval,
+		Out:      os.Stdout,
+	}
+}
+
+// Download fetches every job and returns one result per job in input order
+func (d *Downloader) Download(jobs []DownloadJob) []DownloadResult {
+	return d.DownloadContext(context.Background(), jobs)
+}
+
+// DownloadContext is Download, except that no new transfer is started once
+// ctx is done; the jobs left over fail with the context's error
+func (d *Downloader) DownloadContext(ctx context.Context, jobs []DownloadJob) []DownloadResult {
+	results := make([]DownloadResult, len(jobs))
+	if len(jobs) == 0 {
+		return results
+	}
+
+	if err := d.prepare(); err != nil {
+		for i, job := range jobs {
+			results[i] = DownloadResult{URL: job.URL, Err: err}
+		}
+		return results
+	}
+
+	transfers := make([]*transfer, len(jobs))
+	for i, job := range jobs {
+		transfers[i] = &transfer{job: job, filename: extractFilenameFromURL(job.URL)}
+		transfers[i].size.Store(-1)
+	}
+
+	var total atomic.Int64
+	var finished atomic.Int32
+	stop := make(chan struct{})
+	reported := make(chan struct{})
+	go func() {
+		defer close(reported)
+		d.reportProgress(transfers, &total, &finished, stop)
+	}()
+
+	queue := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < d.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				t := transfers[i]
+				t.active.Store(true)
+				results[i] = d.fetch(t, &total)
+				t.active.Store(false)
+
+				done := finished.Add(1)
+				switch {
+				case results[i].Err != nil:
+					fmt.Fprintf(d.Out, "[%d/%d] ✗ %s: %v\n", done, len(jobs), t.filename, results[i].Err)
+				case results[i].NotModified:
+					fmt.Fprintf(d.Out, "[%d/%d] = %s unchanged\n", done, len(jobs), t.filename)
+				default:
+					fmt.Fprintf(d.Out, "[%d/%d] ✓ %s (%s)\n", done, len(jobs), t.filename, formatBytes(results[i].Bytes))
+				}
+			}
+		}()
+	}
+
+	queued := 0
+	for queued < len(jobs) && ctx.Err() == nil {
+		select {
+		case queue <- queued:
+			queued++
+		case <-ctx.Done():
+		}
+	}
+	close(queue)
+	wg.Wait()
+	close(stop)
+	<-reported
+
+	for i := queued; i < len(jobs); i++ {
+		results[i] = DownloadResult{URL: jobs[i].URL, Path: filepath.Join(d.Dir, transfers[i].filename), Err: ctx.Err()}
+	}
+
+	return results
+}
+
+// prepare creates the download directory; stores need no directories
+func (d *Downloader) prepare() error {
+	if d.Store != nil {
+		return nil
+	}
+	if err := os.MkdirAll(d.Dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return nil
+}
+
+// fetch downloads a single file, resuming from an existing ".part" file if present
+func (d *Downloader) fetch(t *transfer, total *atomic.Int64) DownloadResult {
+	if d.Store != nil {
+		return d.fetchToStore(t, total)
+	}
+
+	finalPath := filepath.Join(d.Dir, t.filename)
+	partPath := finalPath + partSuffix
+	result := DownloadResult{URL: t.job.URL, Path: finalPath}
+
+	if t.filename == "" {
+		result.Err = fmt.Errorf("invalid URL: %s", t.job.URL)
+		return result
+	}
+
+	opts := OpenOptions{ETag: t.job.ETag, LastModified: t.job.LastModified}
+	if info, err := os.Stat(partPath); err == nil && info.Size() > 0 {
+		// A staged file means an earlier attempt already saw new content.
+		// Resume it only while the file is still the version those bytes
+		// came from; without a validator there is no telling, so start over.
+		if validator := readPartValidator(partPath); validator != "" {
+			opts = OpenOptions{Offset: info.Size(), IfRange: validator}
+		} else {
+			removePart(partPath)
+		}
+	}
+	offset := opts.Offset
+
+	remote, err := d.Source.Open(t.job.URL, opts)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer remote.Body.Close()
+
+	result.ETag = remote.ETag
+	result.LastModified = remote.LastModified
+	if remote.NotModified {
+		result.NotModified = true
+		return result
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	if remote.Offset != offset {
+		// The source could not resume from the staged bytes, or the file
+		// changed since they were fetched; start over
+		flags |= os.O_TRUNC
+	}
+	if remote.Offset == 0 {
+		if err := writePartValidator(partPath, remote); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+	result.Resumed = remote.Offset > 0
+	t.size.Store(remote.Size)
+	t.written.Store(remote.Offset)
+	total.Add(remote.Offset)
+
+	hash := sha256.New()
+	if remote.Offset > 0 {
+		if err := hashPrefix(hash, partPath, remote.Offset); err != nil {
+			result.Err = err
+			return result
+		}
+	}
+
+	out, err := os.OpenFile(partPath, flags, 0644)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to create file: %w", err)
+		return result
+	}
+
+	_, copyErr := io.Copy(io.MultiWriter(out, hash, progressWriter{t: t, total: total}), remote.Body)
+	closeErr := out.Close()
+	if copyErr != nil {
+		result.Err = fmt.Errorf("failed to write file (kept %s for resume): %w", partPath, copyErr)
+		return result
+	}
+	if closeErr != nil {
+		result.Err = fmt.Errorf("failed to close file: %w", closeErr)
+		return result
+	}
+
+	written := t.written.Load()
+	if size := t.size.Load(); size >= 0 && written != size {
+		result.Err = fmt.Errorf("incomplete download: got %d of %d bytes (kept %s for resume)", written, size, partPath)
+		return result
+	}
+	if written == 0 {
+		removePart(partPath)
+		result.Err = fmt.Errorf("downloaded file is empty")
+		return result
+	}
+
+	if d.Verify != nil {
+		if err := d.Verify(partPath); err != nil {
+			os.Remove(partPath + validatorSuffix)
+			if dest, qErr := Quarantine(partPath, t.filename, err); qErr == nil {
+				result.Err = fmt.Errorf("failed verification, moved to %s: %w", dest, err)
+			} else {
+				os.Remove(partPath)
+				result.Err = fmt.Errorf("failed verification: %w", err)
+			}
+			return result
+		}
+	}
+
+	if err := os.Rename(partPath, finalPath); err != nil {
+		result.Err = fmt.Errorf("failed to finalize file: %w", err)
+		return result
+	}
+	os.Remove(partPath + validatorSuffix)
+
+	result.Bytes = written
+	result.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return result
+}
+
+// fetchToStore streams a single file into Store without touching local disk.
+// It is staged under its ".part" key until verified. Stores cannot append, so
+// an interrupted transfer starts over on the next run.
+func (d *Downloader) fetchToStore(t *transfer, total *atomic.Int64) DownloadResult {
+	key := storageKey(d.Dir, t.filename)
+	partKey := key + partSuffix
+	result := DownloadResult{URL: t.job.URL, Path: key}
+
+	if t.filename == "" {
+		result.Err = fmt.Errorf("invalid URL: %s", t.job.URL)
+		return result
+	}
+
+	remote, err := d.Source.Open(t.job.URL, OpenOptions{ETag: t.job.ETag, LastModified: t.job.LastModified})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	defer remote.Body.Close()
+
+	result.ETag = remote.ETag
+	result.LastModified = remote.LastModified
+	if remote.NotModified {
+		result.NotModified = true
+		return result
+	}
+	t.size.Store(remote.Size)
+
+	out, err := d.Store.Create(partKey)
+	if err != nil {
+		result.Err = fmt.Errorf("failed to create %s: %w", partKey, err)
+		return result
+	}
+
+	hash := sha256.New()
+	_, copyErr := io.Copy(io.MultiWriter(out, hash, progressWriter{t: t, total: total}), remote.Body)
+	written := t.written.Load()
+	switch {
+	case copyErr != nil:
+		result.Err = fmt.Errorf("failed to write %s: %w", partKey, copyErr)
+	case remote.Size >= 0 && written != remote.Size:
+		result.Err = fmt.Errorf("incomplete download: got %d of %d bytes", written, remote.Size)
+	case written == 0:
+		result.Err = fmt.Errorf("downloaded file is empty")
+	}
+	if result.Err != nil {
+		out.Abort()
+		return result
+	}
+	if err := out.Close(); err != nil {
+		result.Err = fmt.Errorf("failed to close %s: %w", partKey, err)
+		return result
+	}
+
+	if d.VerifyObject != nil {
+		if err := d.VerifyObject(d.Store, partKey); err != nil {
+			dest := storageKey(quarantineDir, t.filename)
+			if qErr := moveObject(d.Store, partKey, dest); qErr == nil {
+				result.Err = fmt.Errorf("failed verification, moved to %s: %w", dest, err)
+			} else {
+				d.Store.Remove(partKey)
+				result.Err = fmt.Errorf("failed verification: %w", err)
+			}
+			return result
+		}
+	}
+
+	if err := moveObject(d.Store, partKey, key); err != nil {
+		result.Err = fmt.Errorf("failed to finalize %s: %w", key, err)
+		return result
+	}
+
+	result.Bytes = written
+	result.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return result
+}
+
+// readPartValidator returns the validator a staged file was fetched under,
+// or "" if none was recorded
+func readPartValidator(partPath string) string {
+	data, err := os.ReadFile(partPath + validatorSuffix)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// writePartValidator records the validator of the version being staged.
+// If-Range only accepts a strong ETag or a date, so a weak ETag falls back
+// to Last-Modified; with neither, nothing is recorded and an interrupted
+// transfer starts over.
+func writePartValidator(partPath string, remote *Remote) error {
+	validator := remote.LastModified
+	if remote.ETag != "" && !strings.HasPrefix(remote.ETag, "W/") {
+		validator = remote.ETag
+	}
+	if validator == "" {
+		os.Remove(partPath + validatorSuffix)
+		return nil
+	}
+	if err := os.WriteFile(partPath+validatorSuffix, []byte(validator+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to record validator of %s: %w", partPath, err)
+	}
+	return nil
+}
+
+// removePart deletes a staged file and its validator
+func removePart(partPath string) {
+	os.Remove(partPath)
+	os.Remove(partPath + validatorSuffix)
+}
+
+// hashPrefix feeds the first n bytes of a staged file into hash
+func hashPrefix(hash io.Writer, path string, n int64) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open partial file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := io.CopyN(hash, file, n); err != nil {
+		return fmt.Errorf("failed to hash partial file: %w", err)
+	}
+	return nil
+}
+
+// reportProgress prints per-file and aggregate progress until stop is closed
+func (d *Downloader) reportProgress(transfers []*transfer, total *atomic.Int64, finished *atomic.Int32, stop <-chan struct{}) {
+	if d.Interval <= 0 {
+		<-stop
+		return
+	}
+
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	start := time.Now()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		var lines []string
+		for _, t := range transfers {
+			if !t.active.Load() {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("  %s %s", t.filename, formatProgress(t.written.Load(), t.size.Load())))
+		}
+
+		rate := float64(total.Load()) / time.Since(start).Seconds()
+		fmt.Fprintf(d.Out, "Progress: %d/%d files, %s downloaded (%s/s)\n",
+			finished.Load(), len(transfers), formatBytes(total.Load()), formatBytes(int64(rate)))
+		for _, line := range lines {
+			fmt.Fprintln(d.Out, line)
+		}
+	}
+}
+
+// parseContentRange parses a "bytes start-end/size" or "bytes */size" header
+func parseContentRange(header string) (start, size int64, ok bool) {
+	spec, found := strings.CutPrefix(header, "bytes ")
+	if !found {
+		return 0, 0, false
+	}
+
+	span, sizeStr, found := strings.Cut(spec, "/")
+	if !found || sizeStr == "*" {
+		return 0, 0, false
+	}
+
+	size, err := strconv.ParseInt(sizeStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+	if span == "*" {
+		return 0, size, true
+	}
+
+	startStr, _, found := strings.Cut(span, "-")
+	if !found {
+		return 0, 0, false
+	}
+	start, err = strconv.ParseInt(startStr, 10, 64)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return start, size, true
+}
+
+// formatProgress renders written bytes against an optional total size
+func formatProgress(written, size int64) string {
+	if size <= 0 {
+		return formatBytes(written)
+	}
+	return fmt.Sprintf("%s / %s (%.1f%%)", formatBytes(written), formatBytes(size), float64(written)*100/float64(size))
+}
+
+// formatBytes renders a byte count using binary units
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDownloaderResume(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+	modified := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// part is the staged file left by an earlier attempt, and validator
+		// what was recorded next to it
+		part      []byte
+		validator string
+		etag      string
+		wantRange string
+		resumed   bool
+	}{
+		{
+			name:      "fresh",
+			etag:      `"v1"`,
+			wantRange: "",
+		},
+		{
+			name:      "resume unchanged",
+			part:      content[:4000],
+			validator: `"v1"`,
+			etag:      `"v1"`,
+			wantRange: "bytes=4000-",
+			resumed:   true,
+		},
+		{
+			name:      "restart changed",
+			part:      []byte(strings.Repeat("x", 4000)),
+			validator: `"v0"`,
+			etag:      `"v1"`,
+			wantRange: "bytes=4000-",
+		},
+		{
+			name:      "resume by date",
+			part:      content[:2500],
+			validator: modified.Format(http.TimeFormat),
+			wantRange: "bytes=2500-",
+			resumed:   true,
+		},
+		{
+			name:      "restart without validator",
+			part:      []byte(strings.Repeat("x", 4000)),
+			etag:      `"v1"`,
+			wantRange: "",
+		},
+		{
+			name:      "complete part",
+			part:      content,
+			validator: `"v1"`,
+			etag:      `"v1"`,
+			wantRange: "bytes=10000-",
+			resumed:   true,
+		},
+		{
+			name:      "part beyond size",
+			part:      append(append([]byte(nil), content...), "tail"...),
+			validator: `"v1"`,
+			etag:      `"v1"`,
+			wantRange: "bytes=10004-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var ranges []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				ranges = append(ranges, r.Header.Get("Range"))
+				mu.Unlock()
+				if tt.etag != "" {
+					w.Header().Set("ETag", tt.etag)
+				}
+				http.ServeContent(w, r, "archive.zip", modified, bytes.NewReader(content))
+			}))
+			defer server.Close()
+
+			dir := t.TempDir()
+			partPath := filepath.Join(dir, "archive.zip"+partSuffix)
+			if tt.part != nil {
+				if err := os.WriteFile(partPath, tt.part, 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.validator != "" {
+				if err := os.WriteFile(partPath+validatorSuffix, []byte(tt.validator+"\n"), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			d := NewDownloader(&HTTPSource{Client: testClient()}, dir, 1)
+			d.Out = io.Discard
+			results := d.Download([]DownloadJob{{URL: server.URL + "/archive.zip"}})
+			result := results[0]
+			if result.Err != nil {
+				t.Fatalf("Download: %v", result.Err)
+			}
+
+			if len(ranges) == 0 || ranges[0] != tt.wantRange {
+				t.Errorf("first Range = %q, want %q", ranges, tt.wantRange)
+			}
+			if result.Resumed != tt.resumed {
+				t.Errorf("Resumed = %v, want %v", result.Resumed, tt.resumed)
+			}
+			got, err := os.ReadFile(filepath.Join(dir, "archive.zip"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, content) {
+				t.Errorf("downloaded %d bytes that differ from the %d served", len(got), len(content))
+			}
+			sum := sha256.Sum256(content)
+			if result.SHA256 != hex.EncodeToString(sum[:]) {
+				t.Errorf("SHA256 = %s, want the hash of the served file", result.SHA256)
+			}
+			for _, leftover := range []string{partPath, partPath + validatorSuffix} {
+				if _, err := os.Stat(leftover); !os.IsNotExist(err) {
+					t.Errorf("%s left behind", filepath.Base(leftover))
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPSourceRangeNotSatisfiable(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentRange string
+		offset       int64
+		wantOffset   int64
+		wantRequests int
+	}{
+		{name: "already complete", contentRange: "bytes */100", offset: 100, wantOffset: 100, wantRequests: 1},
+		{name: "stale range", contentRange: "bytes */80", offset: 100, wantOffset: 0, wantRequests: 2},
+		{name: "no size", contentRange: "", offset: 100, wantOffset: 0, wantRequests: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Header.Get("Range") != "" {
+					if tt.contentRange != "" {
+						w.Header().Set("Content-Range", tt.contentRange)
+					}
+					w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+					return
+				}
+				w.Write([]byte("whole file"))
+			}))
+			defer server.Close()
+
+			src := &HTTPSource{Client: testClient()}
+			remote, err := src.Open(server.URL+"/archive.zip", OpenOptions{Offset: tt.offset, IfRange: `"v1"`})
+			if err != nil {
+				t.Fatalf("Open: %v", err)
+			}
+			defer remote.Body.Close()
+
+			if remote.Offset != tt.wantOffset {
+				t.Errorf("Offset = %d, want %d", remote.Offset, tt.wantOffset)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("sent %d requests, want %d", requests, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestDownloadContextCanceled(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("archive"))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := NewDownloader(&HTTPSource{Client: testClient()}, t.TempDir(), 2)
+	d.Out = io.Discard
+	results := d.DownloadContext(ctx, []DownloadJob{{URL: server.URL + "/a.zip"}, {URL: server.URL + "/b.zip"}})
+	for _, result := range results {
+		if !errors.Is(result.Err, context.Canceled) {
+			t.Errorf("%s: error = %v, want context.Canceled", result.URL, result.Err)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("sent %d requests after cancellation", requests)
+	}
+}
+
+// testClient is a client without rate limiting or backoff
+func testClient() *Client {
+	config := DefaultClientConfig()
+	config.RequestsPerSecond = 0
+	config.MaxRetries = 0
+	config.ReadTimeout = 5 * time.Second
+	return NewClient(config)
+}
@@ -0,0 +1,402 @@
+package main
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	eligibilityDir      = "./data/eo_status"
+	pub78TableFile      = "pub78.csv"
+	revocationTableFile = "revocations.csv"
+	irsPub78URL         = "https://apps.irs.gov/pub/epostcard/data-download-pub78.zip"
+	irsRevocationURL    = "https://apps.irs.gov/pub/epostcard/data-download-revocation.zip"
+)
+
+// Status values reported for an EIN
+const (
+	statusEligible   = "eligible"
+	statusRevoked    = "revoked"
+	statusReinstated = "reinstated"
+	statusNotListed  = "not listed"
+)
+
+var (
+	pub78Columns      = []string{"EIN", "NAME", "CITY", "STATE", "COUNTRY", "DEDUCTIBILITY"}
+	revocationColumns = []string{"EIN", "NAME", "STATE", "SUBSECTION", "REVOCATION_DATE", "POSTING_DATE", "REINSTATEMENT_DATE"}
+)
+
+// eligibilityJoinColumns are appended to CSV output when joining on EIN
+var eligibilityJoinColumns = []string{
+	"DeductibilityStatus",
+	"Pub78Listed",
+	"Pub78Deductibility",
+	"AutoRevoked",
+	"RevocationDate",
+	"RevocationPostingDate",
+	"ReinstatementDate",
+}
+
+// Pub78Record is an organization eligible to receive deductible contributions
+type Pub78Record struct {
+	EIN           string
+	Name          string
+	City          string
+	State         string
+	Country       string
+	Deductibility string // Pub 78 deductibility codes, e.g. PC or POF
+}
+
+// RevocationRecord is an automatic revocation for failing to file for three years
+type RevocationRecord struct {
+	EIN               string
+	Name              string
+	State             string
+	Subsection        string
+	RevocationDate    string // YYYY-MM-DD
+	PostingDate       string
+	ReinstatementDate string // empty unless exemption was reinstated
+}
+
+// EligibilityTable holds Pub 78 listings and automatic revocations by EIN
+type EligibilityTable struct {
+	Pub78       map[string]*Pub78Record
+	Revocations map[string]*RevocationRecord
+}
+
+func newEligibilityTable() *EligibilityTable {
+	return &EligibilityTable{
+		Pub78:       make(map[string]*Pub78Record),
+		Revocations: make(map[string]*RevocationRecord),
+	}
+}
+
+// SyncEligibility downloads the Pub 78 and revocation archives if they changed
+func SyncEligibility(src Source) error {
+	jobs := []DownloadJob{
+		conditionalJob(eligibilityDir, irsPub78URL),
+		conditionalJob(eligibilityDir, irsRevocationURL),
+	}
+
+	fmt.Printf("Fetching Pub 78 and auto-revocation data from %s...\n", src.Name())
+	for _, result := range NewDownloader(src, eligibilityDir, defaultDownloadWorkers).Download(jobs) {
+		if result.Err != nil {
+			return fmt.Errorf("failed to download %s: %w", result.URL, result.Err)
+		}
+	}
+	return nil
+}
+
+// BuildEligibilityTable parses the downloaded Pub 78 and revocation archives
+func BuildEligibilityTable(dir string) (*EligibilityTable, error) {
+	table := newEligibilityTable()
+
+	pub78 := filepath.Join(dir, extractFilenameFromURL(irsPub78URL))
+	count, err := readPipeArchive(pub78, table.addPub78)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Parsed %d Pub 78 listings from %s\n", count, filepath.Base(pub78))
+
+	revocations := filepath.Join(dir, extractFilenameFromURL(irsRevocationURL))
+	count, err = readPipeArchive(revocations, table.addRevocation)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Parsed %d revocations from %s\n", count, filepath.Base(revocations))
+
+	return table, nil
+}
+
+// readPipeArchive calls add with the fields of every line of the text files
+// in a pipe-delimited IRS data download, returning the number of rows added
+func readPipeArchive(path string, add func(fields []string) bool) (int, error) {
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	var count int
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !strings.HasSuffix(strings.ToLower(file.Name), ".txt") {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			return count, fmt.Errorf("failed to open %s in %s: %w", file.Name, path, err)
+		}
+
+		scanner := bufio.NewScanner(rc)
+		scanner.Buffer(make([]byte, 64<<10), 1<<20)
+		for scanner.Scan() {
+			line := strings.TrimSpace(strings.TrimPrefix(scanner.Text(), "\ufeff"))
+			if line == "" {
+				continue
+			}
+			if add(strings.Split(line, "|")) {
+				count++
+			}
+		}
+		err = scanner.Err()
+		rc.Close()
+		if err != nil {
+			return count, fmt.Errorf("failed to read %s in %s: %w", file.Name, path, err)
+		}
+	}
+	return count, nil
+}
+
+// pipeField returns a trimmed field, or "" past the end of the row
+func pipeField(fields []string, i int) string {
+	if i < len(fields) {
+		return strings.TrimSpace(fields[i])
+	}
+	return ""
+}
+
+// addPub78 reads EIN|Name|City|State|Country|Deductibility codes
+func (t *EligibilityTable) addPub78(fields []string) bool {
+	ein := normalizeEIN(pipeField(fields, 0))
+	if len(ein) != 9 {
+		return false
+	}
+	t.Pub78[ein] = &Pub78Record{
+		EIN:           ein,
+		Name:          pipeField(fields, 1),
+		City:          pipeField(fields, 2),
+		State:         pipeField(fields, 3),
+		Country:       pipeField(fields, 4),
+		Deductibility: pipeField(fields, 5),
+	}
+	return true
+}
+
+// addRevocation reads EIN|Name|DBA|Address|City|State|ZIP|Country|Exemption
+// type|Revocation date|Posting date|Reinstatement date, keeping the latest
+// revocation of an EIN
+func (t *EligibilityTable) addRevocation(fields []string) bool {
+	ein := normalizeEIN(pipeField(fields, 0))
+	if len(ein) != 9 {
+		return false
+	}
+	record := &RevocationRecord{
+		EIN:               ein,
+		Name:              pipeField(fields, 1),
+		State:             pipeField(fields, 5),
+		Subsection:        pipeField(fields, 8),
+		RevocationDate:    irsDate(pipeField(fields, 9)),
+		PostingDate:       irsDate(pipeField(fields, 10)),
+		ReinstatementDate: irsDate(pipeField(fields, 11)),
+	}
+	if existing, ok := t.Revocations[ein]; ok && existing.RevocationDate > record.RevocationDate {
+		return true
+	}
+	t.Revocations[ein] = record
+	return true
+}
+
+// irsDate converts the DD-MON-YYYY and MM-DD-YYYY dates used in the downloads
+// to YYYY-MM-DD, leaving values in other formats untouched
+func irsDate(value string) string {
+	if value == "" {
+		return ""
+	}
+	for _, layout := range []string{"02-Jan-2006", "01-02-2006", "01/02/2006", "2006-01-02"} {
+		if when, err := time.Parse(layout, value); err == nil {
+			return when.Format("2006-01-02")
+		}
+	}
+	return value
+}
+
+// Status reports whether an EIN is eligible for deductible contributions,
+// auto-revoked, reinstated after a revocation, or not listed at all
+func (t *EligibilityTable) Status(ein string) string {
+	ein = normalizeEIN(ein)
+	if revocation, ok := t.Revocations[ein]; ok {
+		if revocation.ReinstatementDate != "" {
+			return statusReinstated
+		}
+		return statusRevoked
+	}
+	if _, ok := t.Pub78[ein]; ok {
+		return statusEligible
+	}
+	return statusNotListed
+}
+
+// Describe summarizes an EIN's status for log output
+func (t *EligibilityTable) Describe(ein string) string {
+	status := t.Status(ein)
+	revocation := t.Revocations[normalizeEIN(ein)]
+	switch status {
+	case statusRevoked:
+		return fmt.Sprintf("%s on %s", status, revocation.RevocationDate)
+	case statusReinstated:
+		return fmt.Sprintf("%s on %s (revoked %s)", status, revocation.ReinstatementDate, revocation.RevocationDate)
+	}
+	return status
+}
+
+// Columns implements EINJoin
+func (t *EligibilityTable) Columns() []string {
+	return eligibilityJoinColumns
+}
+
+// Values implements EINJoin
+func (t *EligibilityTable) Values(ein string) []string {
+	ein = normalizeEIN(ein)
+	values := make([]string, len(eligibilityJoinColumns))
+	values[0] = t.Status(ein)
+	values[1], values[3] = "N", "N"
+	if listing, ok := t.Pub78[ein]; ok {
+		values[1] = "Y"
+		values[2] = listing.Deductibility
+	}
+	if revocation, ok := t.Revocations[ein]; ok {
+		values[3] = "Y"
+		values[4] = revocation.RevocationDate
+		values[5] = revocation.PostingDate
+		values[6] = revocation.ReinstatementDate
+	}
+	return values
+}
+
+// Summary prints table sizes and revocation counts
+func (t *EligibilityTable) Summary(w io.Writer) {
+	var reinstated int
+	for _, r := range t.Revocations {
+		if r.ReinstatementDate != "" {
+			reinstated++
+		}
+	}
+	fmt.Fprintf(w, "Pub 78 lists %d organizations\n", len(t.Pub78))
+	fmt.Fprintf(w, "%d organizations were auto-revoked, %d of them reinstated\n", len(t.Revocations), reinstated)
+}
+
+// Save writes both tables to dir
+func (t *EligibilityTable) Save(dir string) error {
+	var pub78 [][]string
+	for _, r := range t.Pub78 {
+		pub78 = append(pub78, []string{r.EIN, r.Name, r.City, r.State, r.Country, r.Deductibility})
+	}
+	if err := writeTable(filepath.Join(dir, pub78TableFile), pub78Columns, pub78); err != nil {
+		return err
+	}
+
+	var revocations [][]string
+	for _, r := range t.Revocations {
+		revocations = append(revocations, []string{r.EIN, r.Name, r.State, r.Subsection, r.RevocationDate, r.PostingDate, r.ReinstatementDate})
+	}
+	return writeTable(filepath.Join(dir, revocationTableFile), revocationColumns, revocations)
+}
+
+// writeTable atomically writes rows sorted by their first column
+func writeTable(path string, header []string, rows [][]string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i][0] < rows[j][0] })
+
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+
+	writer := csv.NewWriter(file)
+	writer.Write(header)
+	writer.WriteAll(rows)
+	if err := writer.Error(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", path, err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// LoadEligibilityTable reads the tables written by Save
+func LoadEligibilityTable(dir string) (*EligibilityTable, error) {
+	table := newEligibilityTable()
+
+	err := readTable(filepath.Join(dir, pub78TableFile), func(row []string) {
+		table.Pub78[row[0]] = &Pub78Record{EIN: row[0], Name: row[1], City: row[2], State: row[3], Country: row[4], Deductibility: row[5]}
+	}, len(pub78Columns))
+	if err != nil {
+		return nil, err
+	}
+
+	err = readTable(filepath.Join(dir, revocationTableFile), func(row []string) {
+		table.Revocations[row[0]] = &RevocationRecord{
+			EIN:               row[0],
+			Name:              row[1],
+			State:             row[2],
+			Subsection:        row[3],
+			RevocationDate:    row[4],
+			PostingDate:       row[5],
+			ReinstatementDate: row[6],
+		}
+	}, len(revocationColumns))
+	if err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+// readTable calls add for each row of a table written by writeTable
+func readTable(path string, add func(row []string), columns int) error {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%s is missing (run the eligibility command first)", path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = columns
+	if _, err := reader.Read(); err != nil {
+		return fmt.Errorf("failed to read header of %s: %w", path, err)
+	}
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		add(row)
+	}
+}
+
+// RebuildEligibility fetches Pub 78 and the revocation list and rewrites the local tables
+func RebuildEligibility(src Source) error {
+	if err := SyncEligibility(src); err != nil {
+		return err
+	}
+
+	table, err := BuildEligibilityTable(eligibilityDir)
+	if err != nil {
+		return err
+	}
+	table.Summary(os.Stdout)
+
+	return table.Save(eligibilityDir)
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/synergos-systems/models/v2023_4_0/IRS990N"
+	"github.com/synergos-systems/models/v2023_4_0/ReturnHeader990N"
+	"github.com/synergos-systems/models/v2023_4_0/common"
+	"github.com/synergos-systems/xsd"
+)
+
+const (
+	ePostcardDir    = "./data/990n"
+	ePostcardCSV    = "irs_990n_data.csv"
+	irsEPostcardURL = "https://apps.irs.gov/pub/epostcard/data-download-epostcard.zip"
+)
+
+// EPostcard is one Form 990-N filing from the e-Postcard bulk download
+type EPostcard struct {
+	XMLName    xml.Name                      `xml:"Return"`
+	Header     ReturnHeader990N.ReturnHeader `xml:"ReturnHeader"`
+	ReturnData struct {
+		Form IRS990N.Irs990N `xml:"IRS990N"`
+	} `xml:"ReturnData"`
+}
+
+// parseEPostcard fills the 990-N models from one line of the bulk file:
+//
+//	EIN|Tax Year|Organization Name|Gross receipts not greater than limit|
+//	Organization has terminated|Tax Period Begin|Tax Period End|Website URL|
+//	Principal Officer's Name|Principal Officer's Address Line 1|Line 2|City|
+//	Province|State|ZIP|Country|Mailing Address Line 1|Line 2|City|Province|
+//	State|ZIP|Country|DBA Name 1|DBA Name 2|DBA Name 3
+func parseEPostcard(fields []string) (*EPostcard, bool) {
+	ein := normalizeEIN(pipeField(fields, 0))
+	if len(ein) != 9 {
+		return nil, false
+	}
+
+	card := &EPostcard{}
+	header := &card.Header
+	header.ReturnTypeCd = "990N"
+	if year, err := xsd.ParseYear(pipeField(fields, 1)); err == nil {
+		header.TaxYr = common.YearType(year)
+	}
+	if begin, err := xsd.ParseDate(irsDate(pipeField(fields, 5))); err == nil {
+		header.TaxPeriodBeginDt = common.DateType(begin)
+	}
+	if end, err := xsd.ParseDate(irsDate(pipeField(fields, 6))); err == nil {
+		header.TaxPeriodEndDt = common.DateType(end)
+	}
+	header.Filer.Ein = common.Eintype(ein)
+	header.Filer.BusinessName.BusinessNameLine1Txt = common.BusinessNameLine1Type(pipeField(fields, 2))
+
+	// The filer's address is the mailing address
+	mailing := ePostcardAddress(fields, 16)
+	if mailing.foreign() {
+		header.Filer.ForeignAddress = &common.ForeignAddressType{
+			AddressLine1Txt:   common.StreetAddressType(mailing.line1),
+			AddressLine2Txt:   optional[common.StreetAddressType](mailing.line2),
+			CityNm:            mailing.city,
+			ProvinceOrStateNm: mailing.province,
+			CountryCd:         common.CountryType(mailing.country),
+			ForeignPostalCd:   mailing.zip,
+		}
+	} else if mailing.line1 != "" {
+		header.Filer.Usaddress = &common.UsaddressType{
+			AddressLine1Txt:     common.StreetAddressType(mailing.line1),
+			AddressLine2Txt:     optional[common.StreetAddressType](mailing.line2),
+			CityNm:              common.CityType(mailing.city),
+			StateAbbreviationCd: common.StateType(mailing.state),
+			Zipcd:               common.ZipcodeType(mailing.zip),
+		}
+	}
+
+	form := &card.ReturnData.Form
+	if ePostcardFlag(pipeField(fields, 3)) {
+		form.GrossReceiptsLimitInd = true
+	}
+	if ePostcardFlag(pipeField(fields, 4)) {
+		final := common.CheckboxType(true)
+		form.FinalReturnInd = &final
+	}
+	form.WebsiteAddressTxt = optional[common.LineExplanationType](pipeField(fields, 7))
+	form.PersonNm = optional[common.PersonNameType](pipeField(fields, 8))
+
+	// The form's name and address group describes the principal officer
+	officer := ePostcardAddress(fields, 9)
+	if officer.foreign() {
+		form.ForeignAddress = &common.ForeignAddressType{
+			AddressLine1Txt:   common.StreetAddressType(officer.line1),
+			AddressLine2Txt:   optional[common.StreetAddressType](officer.line2),
+			CityNm:            officer.city,
+			ProvinceOrStateNm: officer.province,
+			CountryCd:         common.CountryType(officer.country),
+			ForeignPostalCd:   officer.zip,
+		}
+	} else if officer.line1 != "" {
+		form.Usaddress = &common.UsaddressType{
+			AddressLine1Txt:     common.StreetAddressType(officer.line1),
+			AddressLine2Txt:     optional[common.StreetAddressType](officer.line2),
+			CityNm:              common.CityType(officer.city),
+			StateAbbreviationCd: common.StateType(officer.state),
+			Zipcd:               common.ZipcodeType(officer.zip),
+		}
+	}
+
+	for i := 23; i <= 25; i++ {
+		if name := pipeField(fields, i); name != "" {
+			form.DoingBusinessAsName = append(form.DoingBusinessAsName, common.BusinessNameType{
+				BusinessNameLine1Txt: common.BusinessNameLine1Type(name),
+			})
+		}
+	}
+
+	return card, true
+}
+
+// ePostcardAddressFields is one of the two seven-field address blocks
+type ePostcardAddressFields struct {
+	line1, line2, city, province, state, zip, country string
+}
+
+func ePostcardAddress(fields []string, start int) ePostcardAddressFields {
+	return ePostcardAddressFields{
+		line1:    pipeField(fields, start),
+		line2:    pipeField(fields, start+1),
+		city:     pipeField(fields, start+2),
+		province: pipeField(fields, start+3),
+		state:    pipeField(fields, start+4),
+		zip:      pipeField(fields, start+5),
+		country:  pipeField(fields, start+6),
+	}
+}
+
+// foreign reports whether the address is outside the United States
+func (a ePostcardAddressFields) foreign() bool {
+	switch strings.ToUpper(a.country) {
+	case "", "US", "USA", "UNITED STATES", "UNITED STATES OF AMERICA":
+		return false
+	}
+	return true
+}
+
+// ePostcardFlag reads the T/F and Y/N flags used in the bulk file
+func ePostcardFlag(value string) bool {
+	switch strings.ToUpper(value) {
+	case "T", "Y", "TRUE", "YES", "1":
+		return true
+	}
+	return false
+}
+
+// optional returns a pointer to value, or nil when it is empty
+func optional[T ~string](value string) *T {
+	if value == "" {
+		return nil
+	}
+	v := T(value)
+	return &v
+}
+
+// ReadEPostcards calls fn for every filing in a downloaded e-Postcard archive
+func ReadEPostcards(path string, fn func(*EPostcard)) (int, error) {
+	return readPipeArchive(path, func(fields []string) bool {
+		card, ok := parseEPostcard(fields)
+		if ok {
+			fn(card)
+		}
+		return ok
+	})
+}
+
+// ImportEPostcards downloads the e-Postcard bulk file if it changed and writes
+// the filings that pass the filter to the 990-N CSV, with the same columns
+// and EIN joins as the 990 CSV
+func ImportEPostcards(src Source, filter FilingFilter, joins ...EINJoin) error {
+	job := conditionalJob(ePostcardDir, irsEPostcardURL)
+	fmt.Printf("Fetching the e-Postcard bulk file from %s...\n", src.Name())
+	for _, result := range NewDownloader(src, ePostcardDir, 1).Download([]DownloadJob{job}) {
+		if result.Err != nil {
+			return fmt.Errorf("failed to download %s: %w", result.URL, result.Err)
+		}
+	}
+
+	processor, err := NewXMLToCSVProcessor(ePostcardCSV, joins...)
+	if err != nil {
+		return fmt.Errorf("failed to create processor: %w", err)
+	}
+	defer processor.Close()
+
+	archive := extractFilenameFromURL(irsEPostcardURL)
+	var skipped int
+	count, err := ReadEPostcards(filepath.Join(ePostcardDir, archive), func(card *EPostcard) {
+		header := &FilingRecord{
+			EIN:        string(card.Header.Filer.Ein),
+			ReturnType: card.Header.ReturnTypeCd,
+			TaxPeriod:  taxPeriodOf(card.Header.TaxPeriodEndDt.String()),
+		}
+		if !filter.Match(header) {
+			skipped++
+			return
+		}
+
+		entry := fmt.Sprintf("%s_%s", card.Header.Filer.Ein, card.Header.TaxYr)
+		if err := processor.ProcessModel(card, archive, entry); err != nil {
+			log.Printf("Error processing e-Postcard %s: %v", entry, err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Read %d e-Postcards, wrote %d to %s, %d skipped by filter\n", count, processor.processed.Load(), ePostcardCSV, skipped)
+	return nil
+}
@@ -0,0 +1,705 @@
+package main
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+const (
+	defaultExtractWorkers = 4
+	// extractedMarker records a completed extraction inside its directory
+	extractedMarker = ".extracted.json"
+	// extractingMarker exists only while an extraction is running, so finding
+	// it later means the process died halfway through
+	extractingMarker = ".extracting"
+)
+
+// ExtractionState is the completion marker written after an archive is extracted
+type ExtractionState struct {
+	Archive string    `json:"archive"`
+	SHA256  string    `json:"sha256"`
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"mod_time"`
+	Entries int       `json:"entries"`
+	// Filter describes the filing filter the directory was extracted with;
+	// empty means every entry was written
+	Filter      string    `json:"filter,omitempty"`
+	Skipped     int       `json:"skipped,omitempty"`
+	ExtractedAt time.Time `json:"extracted_at"`
+}
+
+// ExtractOptions control ExtractAllZips
+type ExtractOptions struct {
+	Workers int
+	// Force re-extracts archives even when their marker says they are unchanged
+	Force bool
+	// Filter writes only the filings whose ReturnHeader passes it
+	Filter FilingFilter
+	// Archives limits extraction to the named zip files; empty means all
+	Archives []string
+	// Store holds the archives and receives the extracted files; nil means
+	// data/990_zips on local disk
+	Store Storage
+	// SkipPreflight extracts even when the free space looks too small
+	SkipPreflight bool
+}
+
+// extractStats counts what extractZip did
+type extractStats struct {
+	Entries   int
+	Written   int
+	Unchanged int
+	Removed   int
+	// Skipped counts entries left out by the filter, by rejected criterion
+	Skipped map[string]int
+}
+
+// skippedTotal returns the number of entries left out by the filter
+func (s extractStats) skippedTotal() int {
+	total := 0
+	for _, n := range s.Skipped {
+		total += n
+	}
+	return total
+}
+
+// ExtractAllZips extracts all ZIP files in the data/990_zips directory,
+// skipping archives whose completion marker matches their current content,
+// and returns how many it extracted. Once ctx is done no further archive is
+// started.
+func ExtractAllZips(ctx context.Context, opts ExtractOptions) (int, error) {
+	zipDir := zipBaseDir
+	stored := !onDisk(opts.Store)
+
+	archives, err := listArchives(opts.Store)
+	if err != nil {
+		return 0, err
+	}
+
+	only := make(map[string]bool)
+	for _, name := range opts.Archives {
+		only[name] = true
+	}
+
+	// Hashing an archive held in a store means reading all of it, so the
+	// hash the catalog recorded when it was downloaded is used instead
+	catalog := &Catalog{Entries: make(map[string]*CatalogEntry)}
+	if stored {
+		if catalog, err = LoadCatalog(catalogPath); err != nil {
+			return 0, err
+		}
+	}
+
+	var names []string
+	for _, name := range archives {
+		if len(only) > 0 && !only[name] {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	workers := opts.Workers
+	if workers < 1 {
+		workers = 1
+	}
+
+	if !opts.Filter.Empty() {
+		fmt.Printf("Extracting only filings matching %s\n", opts.Filter)
+	}
+
+	if !opts.SkipPreflight {
+		if err := extractPreflight(opts.Store, names, opts).Check(); err != nil {
+			return 0, err
+		}
+	}
+
+	var mu sync.Mutex
+	var extracted, skipped, failed int
+	// A full disk fails every archive after it, so none are started once one hits it
+	var diskFull atomic.Bool
+	filtered := make(map[string]int)
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range queue {
+				zipPath := filepath.Join(zipDir, name)
+				extractDir := filepath.Join(zipDir, strings.TrimSuffix(name, filepath.Ext(name)))
+
+				var stats extractStats
+				var done bool
+				var err error
+				if stored {
+					stats, done, err = extractStoredArchive(opts.Store, name, catalog.Entries[name], opts)
+				} else {
+					stats, done, err = extractArchive(zipPath, extractDir, opts)
+				}
+
+				mu.Lock()
+				switch {
+				case err != nil:
+					failed++
+					fmt.Printf("Error extracting %s: %v\n", name, err)
+					if errors.Is(err, syscall.ENOSPC) {
+						diskFull.Store(true)
+					}
+				case done:
+					extracted++
+					for reason, n := range stats.Skipped {
+						filtered[reason] += n
+					}
+				default:
+					skipped++
+					fmt.Printf("= %s unchanged since last extraction\n", name)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	for _, name := range names {
+		if diskFull.Load() || ctx.Err() != nil {
+			break
+		}
+		select {
+		case queue <- name:
+		case <-ctx.Done():
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if diskFull.Load() {
+		return extracted, fmt.Errorf("disk full after extracting %d archives; free some space (see the gc command) and run unzip again to finish", extracted)
+	}
+	if err := ctx.Err(); err != nil {
+		return extracted, fmt.Errorf("extraction interrupted after %d archives; run unzip again to finish: %w", extracted, err)
+	}
+	fmt.Printf("Extraction complete! Extracted %d ZIP files, %d unchanged, %d failed.\n", extracted, skipped, failed)
+	if !opts.Filter.Empty() {
+		fmt.Printf("Filings skipped by filter: %d wrong EIN, %d wrong return type, %d outside tax periods, %d unreadable headers\n",
+			filtered["ein"], filtered["return type"], filtered["tax period"], filtered["header"])
+	}
+	if failed > 0 {
+		return extracted, fmt.Errorf("%d archives failed to extract", failed)
+	}
+	return extracted, nil
+}
+
+// extractArchive brings extractDir up to date with zipPath. It reports whether
+// anything was extracted; false with a nil error means the archive is unchanged
+// and was last extracted with the same filter.
+func extractArchive(zipPath, extractDir string, opts ExtractOptions) (extractStats, bool, error) {
+	var stats extractStats
+	force := opts.Force
+	filter := opts.Filter.String()
+
+	info, err := os.Stat(zipPath)
+	if err != nil {
+		return stats, false, fmt.Errorf("failed to stat archive: %w", err)
+	}
+
+	// A leftover in-progress marker means an earlier run crashed mid-way
+	if _, err := os.Stat(filepath.Join(extractDir, extractingMarker)); err == nil {
+		fmt.Printf("Cleaning half-extracted directory %s\n", extractDir)
+		if err := os.RemoveAll(extractDir); err != nil {
+			return stats, false, fmt.Errorf("failed to clean half-extracted directory: %w", err)
+		}
+	}
+
+	state, _ := readExtractionState(extractDir)
+	if !force && state != nil && state.Filter == filter && state.Size == info.Size() && state.ModTime.Equal(info.ModTime()) {
+		return stats, false, nil
+	}
+
+	sum, _, err := hashFile(zipPath)
+	if err != nil {
+		return stats, false, err
+	}
+	if !force && state != nil && state.Filter == filter && state.SHA256 == sum {
+		// Touched but identical; remember the new timestamp and move on
+		state.Size = info.Size()
+		state.ModTime = info.ModTime()
+		return stats, false, writeExtractionState(extractDir, state)
+	}
+
+	if err := os.MkdirAll(extractDir, 0755); err != nil {
+		return stats, false, fmt.Errorf("failed to create extraction directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(extractDir, extractingMarker), []byte(sum+"\n"), 0644); err != nil {
+		return stats, false, fmt.Errorf("failed to write extraction marker: %w", err)
+	}
+	os.Remove(filepath.Join(extractDir, extractedMarker))
+
+	fmt.Printf("Extracting %s to %s...\n", filepath.Base(zipPath), extractDir)
+	stats, err = extractZip(zipPath, extractDir, opts.Filter)
+	if err != nil {
+		return stats, false, err
+	}
+
+	state = &ExtractionState{
+		Archive:     filepath.Base(zipPath),
+		SHA256:      sum,
+		Size:        info.Size(),
+		ModTime:     info.ModTime(),
+		Entries:     stats.Entries,
+		Filter:      filter,
+		Skipped:     stats.skippedTotal(),
+		ExtractedAt: time.Now().UTC(),
+	}
+	if err := writeExtractionState(extractDir, state); err != nil {
+		return stats, false, err
+	}
+	if err := os.Remove(filepath.Join(extractDir, extractingMarker)); err != nil {
+		return stats, false, fmt.Errorf("failed to clear extraction marker: %w", err)
+	}
+
+	fmt.Printf("✓ Successfully extracted %s (%d entries: %d written, %d unchanged, %d skipped, %d stale removed)\n",
+		filepath.Base(zipPath), stats.Entries, stats.Written, stats.Unchanged, stats.skippedTotal(), stats.Removed)
+	return stats, true, nil
+}
+
+// listArchives names the zip files in data/990_zips, on local disk or in store
+func listArchives(store Storage) ([]string, error) {
+	var names []string
+	if onDisk(store) {
+		entries, err := os.ReadDir(zipBaseDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read directory: %w", err)
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+				names = append(names, entry.Name())
+			}
+		}
+		return names, nil
+	}
+
+	objects, err := store.List(zipBaseDir + "/")
+	if err != nil {
+		return nil, err
+	}
+	for _, object := range objects {
+		if path.Dir(object.Key) == zipBaseDir && strings.HasSuffix(strings.ToLower(object.Key), ".zip") {
+			names = append(names, path.Base(object.Key))
+		}
+	}
+	return names, nil
+}
+
+// extractStoredArchive extracts an archive held in a Storage to the
+// data/990_zips/<archive>/ prefix of the same store, reading the archive in
+// place. Stores have no directories to mark as in progress, so the completion
+// marker is removed first and written last; an interrupted run leaves no
+// marker and the archive is extracted again. The archive's hash is taken from
+// its catalog entry, which may be nil, when the sizes agree; otherwise the
+// archive is hashed whenever its size or modification time changed.
+func extractStoredArchive(store Storage, name string, entry *CatalogEntry, opts ExtractOptions) (extractStats, bool, error) {
+	stats := extractStats{Skipped: make(map[string]int)}
+	zipKey := storageKey(zipBaseDir, name)
+	dirKey := storageKey(zipBaseDir, strings.TrimSuffix(name, path.Ext(name)))
+	markerKey := storageKey(dirKey, extractedMarker)
+	filter := opts.Filter.String()
+
+	info, err := store.Stat(zipKey)
+	if err != nil {
+		return stats, false, fmt.Errorf("failed to stat archive: %w", err)
+	}
+	var sum string
+	if entry != nil && entry.Size == info.Size {
+		sum = entry.SHA256
+	}
+	state, _ := readStoredExtractionState(store, markerKey)
+	current := !opts.Force && state != nil && state.Filter == filter
+	touched := state == nil || state.Size != info.Size || !state.ModTime.Equal(info.ModTime)
+	if current && !touched && sum == "" {
+		return stats, false, nil
+	}
+	if sum == "" {
+		if sum, _, err = hashObject(store, zipKey); err != nil {
+			return stats, false, err
+		}
+	}
+	if current && state.SHA256 == sum {
+		if !touched {
+			return stats, false, nil
+		}
+		// Touched but identical; remember the new timestamp and move on
+		state.Size = info.Size
+		state.ModTime = info.ModTime
+		return stats, false, writeStoredExtractionState(store, markerKey, state)
+	}
+	if err := store.Remove(markerKey); err != nil {
+		return stats, false, fmt.Errorf("failed to clear extraction marker: %w", err)
+	}
+
+	reader, closer, err := openZip(store, zipKey)
+	if err != nil {
+		return stats, false, err
+	}
+	defer closer.Close()
+
+	fmt.Printf("Extracting %s to %s/%s...\n", name, store.Name(), dirKey)
+	expected := map[string]bool{markerKey: true}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		key := storageKey(dirKey, file.Name)
+		if !strings.HasPrefix(key, dirKey+"/") {
+			return stats, false, fmt.Errorf("illegal file path: %s", file.Name)
+		}
+
+		stats.Entries++
+		if !opts.Filter.Empty() && strings.HasSuffix(strings.ToLower(file.Name), ".xml") {
+			if reason := filterEntry(file, opts.Filter); reason != "" {
+				stats.Skipped[reason]++
+				continue
+			}
+		}
+
+		expected[key] = true
+		if err := storeZipEntry(store, file, key); err != nil {
+			return stats, false, err
+		}
+		stats.Written++
+	}
+
+	// Drop objects left over from an older copy of the archive
+	existing, err := store.List(dirKey + "/")
+	if err != nil {
+		return stats, false, err
+	}
+	for _, object := range existing {
+		if expected[object.Key] {
+			continue
+		}
+		if err := store.Remove(object.Key); err != nil {
+			return stats, false, fmt.Errorf("failed to remove stale files: %w", err)
+		}
+		stats.Removed++
+	}
+
+	state = &ExtractionState{
+		Archive:     name,
+		SHA256:      sum,
+		Size:        info.Size,
+		ModTime:     info.ModTime,
+		Entries:     stats.Entries,
+		Filter:      filter,
+		Skipped:     stats.skippedTotal(),
+		ExtractedAt: time.Now().UTC(),
+	}
+	if err := writeStoredExtractionState(store, markerKey, state); err != nil {
+		return stats, false, err
+	}
+
+	fmt.Printf("✓ Successfully extracted %s (%d entries: %d written, %d skipped, %d stale removed)\n",
+		name, stats.Entries, stats.Written, stats.skippedTotal(), stats.Removed)
+	return stats, true, nil
+}
+
+// storeZipEntry streams one archive entry into store
+func storeZipEntry(store Storage, file *zip.File, key string) error {
+	src, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open file in ZIP: %w", err)
+	}
+	defer src.Close()
+
+	dst, err := store.Create(key)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", key, err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Abort()
+		return fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	return nil
+}
+
+// readStoredExtractionState loads the completion marker of a stored extraction
+func readStoredExtractionState(store Storage, key string) (*ExtractionState, error) {
+	object, err := store.Open(key)
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+
+	var state ExtractionState
+	if err := json.NewDecoder(object).Decode(&state); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction marker: %w", err)
+	}
+	return &state, nil
+}
+
+// writeStoredExtractionState stores the completion marker of a stored extraction
+func writeStoredExtractionState(store Storage, key string, state *ExtractionState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode extraction marker: %w", err)
+	}
+
+	out, err := store.Create(key)
+	if err != nil {
+		return fmt.Errorf("failed to write extraction marker: %w", err)
+	}
+	if _, err := out.Write(append(data, '\n')); err != nil {
+		out.Abort()
+		return fmt.Errorf("failed to write extraction marker: %w", err)
+	}
+	return out.Close()
+}
+
+// readExtractionState loads the completion marker of an extracted directory
+func readExtractionState(extractDir string) (*ExtractionState, error) {
+	data, err := os.ReadFile(filepath.Join(extractDir, extractedMarker))
+	if err != nil {
+		return nil, err
+	}
+
+	var state ExtractionState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("failed to parse extraction marker: %w", err)
+	}
+	return &state, nil
+}
+
+// writeExtractionState stores the completion marker of an extracted directory
+func writeExtractionState(extractDir string, state *ExtractionState) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode extraction marker: %w", err)
+	}
+
+	tmp := filepath.Join(extractDir, extractedMarker+".tmp")
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write extraction marker: %w", err)
+	}
+	return os.Rename(tmp, filepath.Join(extractDir, extractedMarker))
+}
+
+// extractZip extracts a single ZIP file to the specified directory. Files that
+// already match their entry's size and CRC-32 are left alone, and files the
+// archive no longer contains are removed. With a non-empty filter, each XML
+// entry's ReturnHeader is read from the archive first and only matching
+// filings are written.
+func extractZip(zipPath, extractDir string, filter FilingFilter) (extractStats, error) {
+	stats := extractStats{Skipped: make(map[string]int)}
+
+	// Open the ZIP file
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return stats, fmt.Errorf("failed to open ZIP file: %w", err)
+	}
+	defer reader.Close()
+
+	// Create the extraction directory
+	if err := os.MkdirAll(extractDir, 0755); err != nil {
+		return stats, fmt.Errorf("failed to create extraction directory: %w", err)
+	}
+
+	expected := make(map[string]bool)
+
+	// Extract each file in the ZIP
+	for _, file := range reader.File {
+		filePath := filepath.Join(extractDir, file.Name)
+
+		// Check for path traversal
+		if !strings.HasPrefix(filePath, filepath.Clean(extractDir)+string(os.PathSeparator)) {
+			return stats, fmt.Errorf("illegal file path: %s", filePath)
+		}
+
+		if file.FileInfo().IsDir() {
+			// Create directory
+			if err := os.MkdirAll(filePath, 0755); err != nil {
+				return stats, fmt.Errorf("failed to create directory: %w", err)
+			}
+			continue
+		}
+
+		stats.Entries++
+
+		if !filter.Empty() && strings.HasSuffix(strings.ToLower(file.Name), ".xml") {
+			if reason := filterEntry(file, filter); reason != "" {
+				stats.Skipped[reason]++
+				continue
+			}
+		}
+
+		expected[filePath] = true
+
+		if unchangedOnDisk(filePath, file) {
+			stats.Unchanged++
+			continue
+		}
+
+		if err := writeZipEntry(file, filePath); err != nil {
+			return stats, err
+		}
+		stats.Written++
+	}
+
+	// Drop files left over from an older copy of the archive
+	err = filepath.WalkDir(extractDir, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if d.IsDir() || expected[path] || filepath.Dir(path) == filepath.Clean(extractDir) && strings.HasPrefix(d.Name(), ".") {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return err
+		}
+		stats.Removed++
+		return nil
+	})
+	if err != nil {
+		return stats, fmt.Errorf("failed to remove stale files: %w", err)
+	}
+
+	return stats, nil
+}
+
+// writeZipEntry copies one archive entry to filePath
+func writeZipEntry(file *zip.File, filePath string) error {
+	// Create parent directories for the file
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return fmt.Errorf("failed to create parent directories: %w", err)
+	}
+
+	// Open the file in the ZIP
+	zipFile, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to open file in ZIP: %w", err)
+	}
+	defer zipFile.Close()
+
+	// Create the output file
+	outputFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, file.Mode().Perm()|0600)
+	if err != nil {
+		return fmt.Errorf("failed to create output file: %w", err)
+	}
+
+	// Copy the file contents
+	if _, err := io.Copy(outputFile, zipFile); err != nil {
+		outputFile.Close()
+		return fmt.Errorf("failed to copy file contents: %w", err)
+	}
+
+	return outputFile.Close()
+}
+
+// unchangedOnDisk reports whether filePath already holds the entry's content
+func unchangedOnDisk(filePath string, file *zip.File) bool {
+	info, err := os.Stat(filePath)
+	if err != nil || uint64(info.Size()) != file.UncompressedSize64 {
+		return false
+	}
+
+	existing, err := os.Open(filePath)
+	if err != nil {
+		return false
+	}
+	defer existing.Close()
+
+	hash := crc32.NewIEEE()
+	if _, err := io.Copy(hash, existing); err != nil {
+		return false
+	}
+	return hash.Sum32() == file.CRC32
+}
+
+// filterEntry reads the ReturnHeader of an archive entry and names the filter
+// criterion it fails, "header" if the header can't be read, or "" if it passes
+func filterEntry(file *zip.File, filter FilingFilter) string {
+	entry, err := file.Open()
+	if err != nil {
+		return "header"
+	}
+	defer entry.Close()
+
+	header, err := readReturnHeader(entry)
+	if err != nil {
+		return "header"
+	}
+	return filter.Reject(header)
+}
+
+// taxPeriodOf turns a period end date such as 2023-12-31 into 202312, the
+// format of the index TAX_PERIOD column
+func taxPeriodOf(date string) string {
+	period := strings.ReplaceAll(date, "-", "")
+	if len(period) > 6 {
+		period = period[:6]
+	}
+	return period
+}
+
+// readReturnHeader streams a filing until the end of its ReturnHeader and
+// returns the fields filters look at. It understands both current element
+// names and the ones used by pre-2013 schemas.
+func readReturnHeader(r io.Reader) (*FilingRecord, error) {
+	decoder := xml.NewDecoder(r)
+	header := &FilingRecord{}
+	var path []string
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no ReturnHeader found")
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			path = append(path, t.Name.Local)
+		case xml.EndElement:
+			if t.Name.Local == "ReturnHeader" {
+				return header, nil
+			}
+			if len(path) > 0 {
+				path = path[:len(path)-1]
+			}
+		case xml.CharData:
+			if len(path) < 2 {
+				continue
+			}
+			value := strings.TrimSpace(string(t))
+			if value == "" {
+				continue
+			}
+			parent, name := path[len(path)-2], path[len(path)-1]
+			switch {
+			case parent == "Filer" && name == "EIN":
+				header.EIN = normalizeEIN(value)
+			case parent == "ReturnHeader" && (name == "ReturnTypeCd" || name == "ReturnType"):
+				header.ReturnType = value
+			case parent == "ReturnHeader" && (name == "TaxPeriodEndDt" || name == "TaxPeriodEndDate"):
+				header.TaxPeriod = taxPeriodOf(value)
+			}
+		}
+	}
+}
@@ -0,0 +1,337 @@
+package main
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// manifestDir holds the entry listing of every archive, kept next to the
+	// archives so the listing of a copy survives the copy being replaced
+	manifestDir     = "data/990_manifests"
+	filingChangeLog = "./data/filing_changes.jsonl"
+	reprocessPath   = "./data/reprocess.json"
+)
+
+// Kinds of filing change
+const (
+	FilingAdded    = "added"
+	FilingRemoved  = "removed"
+	FilingModified = "modified"
+)
+
+// ManifestEntry is one filing in an archive's central directory
+type ManifestEntry struct {
+	Entry string `json:"entry"`
+	CRC32 uint32 `json:"crc32"`
+	Size  uint64 `json:"size"`
+}
+
+// ArchiveManifest lists the filings in one copy of an archive, keyed by OBJECT_ID
+type ArchiveManifest struct {
+	Archive string                   `json:"archive"`
+	SHA256  string                   `json:"sha256"`
+	Filings map[string]ManifestEntry `json:"filings"`
+}
+
+// FilingChanges lists the filings that differ between two copies of an archive
+type FilingChanges struct {
+	Archive    string    `json:"archive"`
+	DetectedAt time.Time `json:"detected_at"`
+	OldSHA256  string    `json:"old_sha256"`
+	NewSHA256  string    `json:"new_sha256"`
+	Added      []string  `json:"added,omitempty"`
+	Removed    []string  `json:"removed,omitempty"`
+	Modified   []string  `json:"modified,omitempty"`
+}
+
+// PendingFiling is a filing whose downstream rows are out of date
+type PendingFiling struct {
+	Archive    string    `json:"archive"`
+	Entry      string    `json:"entry"`
+	Change     string    `json:"change"`
+	DetectedAt time.Time `json:"detected_at"`
+}
+
+// ReprocessQueue records the filings that changed upstream and still need
+// their downstream rows rewritten, keyed by OBJECT_ID
+type ReprocessQueue struct {
+	path    string
+	Filings map[string]*PendingFiling `json:"filings"`
+}
+
+// BuildManifest lists the XML entries of an archive from its central
+// directory. The CRC-32 of each entry is stored there, so nothing is
+// decompressed.
+func BuildManifest(archive, sum string, reader *zip.Reader) *ArchiveManifest {
+	manifest := &ArchiveManifest{Archive: archive, SHA256: sum, Filings: make(map[string]ManifestEntry)}
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !strings.HasSuffix(strings.ToLower(file.Name), ".xml") {
+			continue
+		}
+		manifest.Filings[objectIDFromFilename(path.Base(file.Name))] = ManifestEntry{
+			Entry: file.Name,
+			CRC32: file.CRC32,
+			Size:  file.UncompressedSize64,
+		}
+	}
+	return manifest
+}
+
+// manifestKey is where the manifest of an archive is stored
+func manifestKey(archive string) string {
+	return storageKey(manifestDir, strings.TrimSuffix(archive, path.Ext(archive))+".json")
+}
+
+// loadManifest reads the stored manifest of an archive, returning nil if there is none
+func loadManifest(store Storage, archive string) (*ArchiveManifest, error) {
+	object, err := store.Open(manifestKey(archive))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open manifest of %s: %w", archive, err)
+	}
+	defer object.Close()
+
+	var manifest ArchiveManifest
+	if err := json.NewDecoder(object).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest of %s: %w", archive, err)
+	}
+	return &manifest, nil
+}
+
+// saveManifest stores the manifest of an archive, replacing the previous copy's
+func saveManifest(store Storage, manifest *ArchiveManifest) error {
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	out, err := store.Create(manifestKey(manifest.Archive))
+	if err != nil {
+		return fmt.Errorf("failed to create manifest of %s: %w", manifest.Archive, err)
+	}
+	if _, err := out.Write(append(data, '\n')); err != nil {
+		out.Abort()
+		return fmt.Errorf("failed to write manifest of %s: %w", manifest.Archive, err)
+	}
+	return out.Close()
+}
+
+// DiffManifests compares the filings of two copies of an archive. A filing is
+// modified when its CRC-32 or size differs.
+func DiffManifests(old, new *ArchiveManifest) FilingChanges {
+	changes := FilingChanges{
+		Archive:    new.Archive,
+		DetectedAt: time.Now().UTC(),
+		OldSHA256:  old.SHA256,
+		NewSHA256:  new.SHA256,
+	}
+	for id, entry := range new.Filings {
+		previous, ok := old.Filings[id]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, id)
+		case previous.CRC32 != entry.CRC32 || previous.Size != entry.Size:
+			changes.Modified = append(changes.Modified, id)
+		}
+	}
+	for id := range old.Filings {
+		if _, ok := new.Filings[id]; !ok {
+			changes.Removed = append(changes.Removed, id)
+		}
+	}
+
+	sort.Strings(changes.Added)
+	sort.Strings(changes.Removed)
+	sort.Strings(changes.Modified)
+	return changes
+}
+
+// Empty reports whether no filing changed
+func (c FilingChanges) Empty() bool {
+	return len(c.Added)+len(c.Removed)+len(c.Modified) == 0
+}
+
+// Print writes a one-line summary of the changes
+func (c FilingChanges) Print(w io.Writer) {
+	fmt.Fprintf(w, "  %s: %d filings added, %d removed, %d modified\n", c.Archive, len(c.Added), len(c.Removed), len(c.Modified))
+}
+
+// appendFilingChanges adds the changes as one JSON line to the change log
+func appendFilingChanges(path string, c FilingChanges) error {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode filing changes: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create change log directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to open change log: %w", err)
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write change log: %w", err)
+	}
+	return file.Close()
+}
+
+// LoadReprocessQueue reads the queue at path, returning an empty one if it does not exist yet
+func LoadReprocessQueue(path string) (*ReprocessQueue, error) {
+	queue := &ReprocessQueue{path: path, Filings: make(map[string]*PendingFiling)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return queue, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reprocess queue: %w", err)
+	}
+	if err := json.Unmarshal(data, queue); err != nil {
+		return nil, fmt.Errorf("failed to parse reprocess queue: %w", err)
+	}
+	if queue.Filings == nil {
+		queue.Filings = make(map[string]*PendingFiling)
+	}
+	return queue, nil
+}
+
+// Save writes the queue atomically
+func (q *ReprocessQueue) Save() error {
+	if err := os.MkdirAll(filepath.Dir(q.path), 0755); err != nil {
+		return fmt.Errorf("failed to create queue directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(q, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode reprocess queue: %w", err)
+	}
+
+	tmp := q.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write reprocess queue: %w", err)
+	}
+	return os.Rename(tmp, q.path)
+}
+
+// Mark queues every changed filing. Removed filings keep the entry name of
+// the old copy so their rows can still be found.
+func (q *ReprocessQueue) Mark(changes FilingChanges, old, new *ArchiveManifest) {
+	mark := func(ids []string, change string, manifest *ArchiveManifest) {
+		for _, id := range ids {
+			q.Filings[id] = &PendingFiling{
+				Archive:    changes.Archive,
+				Entry:      manifest.Filings[id].Entry,
+				Change:     change,
+				DetectedAt: changes.DetectedAt,
+			}
+		}
+	}
+	mark(changes.Added, FilingAdded, new)
+	mark(changes.Modified, FilingModified, new)
+	mark(changes.Removed, FilingRemoved, old)
+}
+
+// Pending returns the queued filings of an archive: the entries whose rows
+// are stale, and the subset that still exist and must be processed again
+func (q *ReprocessQueue) Pending(archive string) (stale, reprocess map[string]bool) {
+	stale = make(map[string]bool)
+	reprocess = make(map[string]bool)
+	for _, filing := range q.Filings {
+		if filing.Archive != archive {
+			continue
+		}
+		stale[filing.Entry] = true
+		if filing.Change != FilingRemoved {
+			reprocess[filing.Entry] = true
+		}
+	}
+	return stale, reprocess
+}
+
+// Clear removes the queued filings of an archive once its rows are rewritten
+func (q *ReprocessQueue) Clear(archive string) {
+	for id, filing := range q.Filings {
+		if filing.Archive == archive {
+			delete(q.Filings, id)
+		}
+	}
+}
+
+// Print lists the queued filings by archive and kind of change
+func (q *ReprocessQueue) Print(w io.Writer) {
+	if len(q.Filings) == 0 {
+		fmt.Fprintln(w, "No filings waiting to be reprocessed.")
+		return
+	}
+
+	counts := make(map[string]map[string]int)
+	for _, filing := range q.Filings {
+		if counts[filing.Archive] == nil {
+			counts[filing.Archive] = make(map[string]int)
+		}
+		counts[filing.Archive][filing.Change]++
+	}
+	archives := make([]string, 0, len(counts))
+	for archive := range counts {
+		archives = append(archives, archive)
+	}
+	sort.Strings(archives)
+
+	fmt.Fprintf(w, "%d filings in %d archives waiting to be reprocessed:\n", len(q.Filings), len(counts))
+	for _, archive := range archives {
+		c := counts[archive]
+		fmt.Fprintf(w, "  %s: %d added, %d removed, %d modified\n", archive, c[FilingAdded], c[FilingRemoved], c[FilingModified])
+	}
+}
+
+// trackFilingChanges lists the filings of the stored copy of an archive and
+// compares them with the listing of the copy it replaced. Changes are
+// appended to the change log and queued for reprocessing. It returns nil when
+// there is no earlier listing to compare with, which is the case the first
+// time an archive is seen.
+func trackFilingChanges(store Storage, queue *ReprocessQueue, key, sum string) (*FilingChanges, error) {
+	archive := path.Base(key)
+	old, err := loadManifest(store, archive)
+	if err != nil {
+		return nil, err
+	}
+	if old != nil && old.SHA256 == sum {
+		return nil, nil
+	}
+
+	reader, closer, err := openZip(store, key)
+	if err != nil {
+		return nil, err
+	}
+	manifest := BuildManifest(archive, sum, reader)
+	closer.Close()
+
+	if err := saveManifest(store, manifest); err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return nil, nil
+	}
+
+	changes := DiffManifests(old, manifest)
+	if err := appendFilingChanges(filingChangeLog, changes); err != nil {
+		return &changes, err
+	}
+	queue.Mark(changes, old, manifest)
+	return &changes, nil
+}
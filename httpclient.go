@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	defaultUserAgent = "theIRS/1.0 (+https://github.com/synergos-systems/theIRS)"
+	maxRetryAfter    = 5 * time.Minute
+)
+
+// ClientConfig controls how the crawler talks to remote servers
+type ClientConfig struct {
+	// ConnectTimeout bounds dialing and the TLS handshake
+	ConnectTimeout time.Duration
+	// ReadTimeout bounds the wait for response headers and for each read
+	// of the body, so a stalled transfer fails instead of hanging forever
+	ReadTimeout time.Duration
+	// MaxRetries is the number of extra attempts after a failed request
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the exponential backoff between attempts
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RequestsPerSecond limits how often each host is contacted; zero disables the limit
+	RequestsPerSecond float64
+	// MaxConnsPerHost caps simultaneous connections to one host
+	MaxConnsPerHost int
+	UserAgent       string
+}
+
+// DefaultClientConfig returns settings that are polite to the IRS servers
+func DefaultClientConfig() ClientConfig {
+	return ClientConfig{
+		ConnectTimeout:    30 * time.Second,
+		ReadTimeout:       60 * time.Second,
+		MaxRetries:        5,
+		MinBackoff:        time.Second,
+		MaxBackoff:        time.Minute,
+		RequestsPerSecond: 2,
+		MaxConnsPerHost:   defaultDownloadWorkers,
+		UserAgent:         defaultUserAgent,
+	}
+}
+
+// Client is the HTTP client shared by every crawler request
+type Client struct {
+	config   ClientConfig
+	http     *http.Client
+	mu       sync.Mutex
+	limiters map[string]*hostLimiter
+}
+
+// hostLimiter spaces out requests to a single host
+type hostLimiter struct {
+	mu   sync.Mutex
+	next time.Time
+}
+
+// NewClient creates a client from config
+func NewClient(config ClientConfig) *Client {
+	dialer := &net.Dialer{Timeout: config.ConnectTimeout, KeepAlive: 30 * time.Second}
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		TLSHandshakeTimeout:   config.ConnectTimeout,
+		ResponseHeaderTimeout: config.ReadTimeout,
+		MaxConnsPerHost:       config.MaxConnsPerHost,
+		MaxIdleConnsPerHost:   config.MaxConnsPerHost,
+		IdleConnTimeout:       90 * time.Second,
+		ForceAttemptHTTP2:     true,
+	}
+
+	return &Client{
+		config:   config,
+		http:     &http.Client{Transport: transport},
+		limiters: make(map[string]*hostLimiter),
+	}
+}
+
+// Do sends a request, retrying network errors, 429s and 5xx responses with
+// exponential backoff. Only the final response is returned. Requests other
+// than GET and HEAD are only sent when their body can be replayed.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead && req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return nil, fmt.Errorf("refusing to retry %s request", req.Method)
+	}
+
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		c.wait(req.Context(), req.URL.Host)
+
+		res, err := c.attempt(req)
+		var retryAfter time.Duration
+		switch {
+		case err != nil:
+			lastErr = err
+		case !retryable(res.StatusCode):
+			return res, nil
+		default:
+			lastErr = fmt.Errorf("HTTP error: %d", res.StatusCode)
+			retryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
+			io.Copy(io.Discard, io.LimitReader(res.Body, 64<<10))
+			res.Body.Close()
+		}
+
+		if attempt >= c.config.MaxRetries || req.Context().Err() != nil {
+			return nil, fmt.Errorf("giving up on %s after %d attempts: %w", req.URL, attempt+1, lastErr)
+		}
+
+		delay := c.backoff(attempt)
+		if retryAfter > delay {
+			delay = min(retryAfter, maxRetryAfter)
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+	}
+}
+
+// Get fetches url
+func (c *Client) Get(url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	return c.Do(req)
+}
+
+// attempt sends one request whose body fails if it goes quiet for longer than the read timeout
+func (c *Client) attempt(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(req.Context())
+	attempt := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, fmt.Errorf("failed to replay request body: %w", err)
+		}
+		attempt.Body = body
+	}
+	if c.config.UserAgent != "" {
+		attempt.Header.Set("User-Agent", c.config.UserAgent)
+	}
+
+	res, err := c.http.Do(attempt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	if c.config.ReadTimeout > 0 {
+		res.Body = newIdleTimeoutBody(res.Body, c.config.ReadTimeout, cancel)
+	} else {
+		res.Body = cancelBody{ReadCloser: res.Body, cancel: cancel}
+	}
+	return res, nil
+}
+
+// wait blocks until host may be contacted again under the rate limit
+func (c *Client) wait(ctx context.Context, host string) {
+	if c.config.RequestsPerSecond <= 0 {
+		return
+	}
+	interval := time.Duration(float64(time.Second) / c.config.RequestsPerSecond)
+
+	c.mu.Lock()
+	limiter, ok := c.limiters[host]
+	if !ok {
+		limiter = &hostLimiter{}
+		c.limiters[host] = limiter
+	}
+	c.mu.Unlock()
+
+	limiter.mu.Lock()
+	now := time.Now()
+	slot := limiter.next
+	if slot.Before(now) {
+		slot = now
+	}
+	limiter.next = slot.Add(interval)
+	limiter.mu.Unlock()
+
+	select {
+	case <-time.After(time.Until(slot)):
+	case <-ctx.Done():
+	}
+}
+
+// backoff returns the delay before the next attempt, with jitter
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.config.MinBackoff << attempt
+	if delay <= 0 || delay > c.config.MaxBackoff {
+		delay = c.config.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// retryable reports whether a response status is worth another attempt
+func retryable(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(value); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// cancelBody releases the request context when the body is closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// idleTimeoutBody cancels the request when a read waits on the network for
+// longer than the timeout. The clock only runs inside Read, so a consumer
+// that takes its time between reads, such as one uploading each chunk
+// elsewhere, does not count against it.
+type idleTimeoutBody struct {
+	body     io.ReadCloser
+	timeout  time.Duration
+	timer    *time.Timer
+	cancel   context.CancelFunc
+	timedOut atomic.Bool
+}
+
+func newIdleTimeoutBody(body io.ReadCloser, timeout time.Duration, cancel context.CancelFunc) *idleTimeoutBody {
+	b := &idleTimeoutBody{body: body, timeout: timeout, cancel: cancel}
+	b.timer = time.AfterFunc(timeout, func() {
+		b.timedOut.Store(true)
+		cancel()
+	})
+	b.timer.Stop()
+	return b
+}
+
+func (b *idleTimeoutBody) Read(p []byte) (int, error) {
+	b.timer.Reset(b.timeout)
+	n, err := b.body.Read(p)
+	b.timer.Stop()
+	if err != nil && err != io.EOF && b.timedOut.Load() {
+		return n, fmt.Errorf("no data received for %s: %w", b.timeout, err)
+	}
+	return n, err
+}
+
+func (b *idleTimeoutBody) Close() error {
+	b.timer.Stop()
+	err := b.body.Close()
+	b.cancel()
+	return err
+}
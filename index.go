@@ -0,0 +1,678 @@
+package main
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	indexDir       = "./data/990_index"
+	filingIndexCSV = "filings.csv"
+	// firstIndexYear is the first year fetched when the download page links
+	// no index CSVs
+	firstIndexYear = 2019
+)
+
+var indexFilePattern = regexp.MustCompile(`^index_(\d{4})\.csv$`)
+
+// FilingRecord is one row of the IRS yearly index, linked to the XML file
+// that holds the filing once its archive has been extracted
+type FilingRecord struct {
+	ObjectID       string
+	EIN            string
+	TaxpayerName   string
+	ReturnType     string
+	TaxPeriod      string
+	SubmissionDate string
+	ReturnID       string
+	FilingType     string
+	DLN            string
+	BatchID        string
+	IndexYear      string
+	Archive        string
+	// Entry is the filing's path inside the archive, XMLPath its extracted copy
+	Entry   string
+	XMLPath string
+}
+
+// filingIndexHeader is the column order of the combined filings.csv
+var filingIndexHeader = []string{
+	"OBJECT_ID",
+	"EIN",
+	"TAXPAYER_NAME",
+	"RETURN_TYPE",
+	"TAX_PERIOD",
+	"SUB_DATE",
+	"RETURN_ID",
+	"FILING_TYPE",
+	"DLN",
+	"XML_BATCH_ID",
+	"INDEX_YEAR",
+	"ARCHIVE",
+	"ENTRY",
+	"XML_PATH",
+}
+
+func (r *FilingRecord) row() []string {
+	return []string{
+		r.ObjectID,
+		r.EIN,
+		r.TaxpayerName,
+		r.ReturnType,
+		r.TaxPeriod,
+		r.SubmissionDate,
+		r.ReturnID,
+		r.FilingType,
+		r.DLN,
+		r.BatchID,
+		r.IndexYear,
+		r.Archive,
+		r.Entry,
+		r.XMLPath,
+	}
+}
+
+// FilingIndex is the local index of every filing listed in the IRS yearly
+// index files
+type FilingIndex struct {
+	Records  []*FilingRecord
+	byObject map[string]*FilingRecord
+	byEIN    map[string][]*FilingRecord
+}
+
+// FilingFilter selects filings from the index. Empty sets match everything.
+type FilingFilter struct {
+	EINs        map[string]bool
+	ReturnTypes map[string]bool
+	// FromPeriod and ToPeriod bound the tax period end as YYYYMM; empty means unbounded
+	FromPeriod, ToPeriod string
+}
+
+// ParseFilingFilter builds a filter from comma separated EINs and return types
+func ParseFilingFilter(eins, returnTypes string) FilingFilter {
+	filter := FilingFilter{}
+	filter.addEINs(strings.Split(eins, ","))
+	for _, returnType := range strings.Split(returnTypes, ",") {
+		if returnType = strings.ToUpper(strings.TrimSpace(returnType)); returnType != "" {
+			if filter.ReturnTypes == nil {
+				filter.ReturnTypes = make(map[string]bool)
+			}
+			filter.ReturnTypes[returnType] = true
+		}
+	}
+	return filter
+}
+
+// LoadEINFile adds the EINs listed in a file to the filter, one per line or
+// comma separated. Blank lines and lines starting with # are ignored.
+func (f *FilingFilter) LoadEINFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read EIN list: %w", err)
+	}
+
+	before := len(f.EINs)
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f.addEINs(strings.Split(line, ","))
+	}
+	if len(f.EINs) == before {
+		return fmt.Errorf("no EINs found in %s", path)
+	}
+	return nil
+}
+
+func (f *FilingFilter) addEINs(eins []string) {
+	for _, ein := range eins {
+		if ein = normalizeEIN(ein); ein != "" {
+			if f.EINs == nil {
+				f.EINs = make(map[string]bool)
+			}
+			f.EINs[ein] = true
+		}
+	}
+}
+
+// SetTaxPeriods restricts the filter to a tax period range: a year or YYYYMM
+// period, or an inclusive range of either ("2022-2023", "202207-202306")
+func (f *FilingFilter) SetTaxPeriods(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	low, high, isRange := strings.Cut(value, "-")
+	if !isRange {
+		high = low
+	}
+	from, err := taxPeriodBound(low, false)
+	if err != nil {
+		return fmt.Errorf("invalid tax period %q: %w", value, err)
+	}
+	to, err := taxPeriodBound(high, true)
+	if err != nil {
+		return fmt.Errorf("invalid tax period %q: %w", value, err)
+	}
+	if to < from {
+		return fmt.Errorf("invalid tax period %q: range ends before it starts", value)
+	}
+
+	f.FromPeriod, f.ToPeriod = from, to
+	return nil
+}
+
+// taxPeriodBound expands a year to its first or last month as YYYYMM
+func taxPeriodBound(value string, end bool) (string, error) {
+	value = strings.TrimSpace(value)
+	if _, err := strconv.Atoi(value); err != nil {
+		return "", fmt.Errorf("%q is not a year or YYYYMM period", value)
+	}
+
+	switch len(value) {
+	case 4:
+		if end {
+			return value + "12", nil
+		}
+		return value + "01", nil
+	case 6:
+		if month, _ := strconv.Atoi(value[4:]); month < 1 || month > 12 {
+			return "", fmt.Errorf("%q has no month %s", value, value[4:])
+		}
+		return value, nil
+	}
+	return "", fmt.Errorf("%q is not a year or YYYYMM period", value)
+}
+
+// Empty reports whether the filter matches every filing
+func (f FilingFilter) Empty() bool {
+	return len(f.EINs) == 0 && len(f.ReturnTypes) == 0 && f.FromPeriod == ""
+}
+
+// Match reports whether a filing passes the filter
+func (f FilingFilter) Match(r *FilingRecord) bool {
+	return f.Reject(r) == ""
+}
+
+// Reject names the criterion a filing fails ("ein", "return type" or "tax
+// period"), or returns "" when it passes the filter
+func (f FilingFilter) Reject(r *FilingRecord) string {
+	if len(f.EINs) > 0 && !f.EINs[r.EIN] {
+		return "ein"
+	}
+	if len(f.ReturnTypes) > 0 && !f.ReturnTypes[strings.ToUpper(r.ReturnType)] {
+		return "return type"
+	}
+	if f.FromPeriod != "" && (r.TaxPeriod < f.FromPeriod || r.TaxPeriod > f.ToPeriod) {
+		return "tax period"
+	}
+	return ""
+}
+
+// String describes the filter for log output and extraction markers
+func (f FilingFilter) String() string {
+	if f.Empty() {
+		return ""
+	}
+
+	var parts []string
+	if len(f.ReturnTypes) > 0 {
+		parts = append(parts, "return types "+strings.Join(sortedKeys(f.ReturnTypes), ","))
+	}
+	if len(f.EINs) > 0 {
+		eins := sortedKeys(f.EINs)
+		sum := sha256.Sum256([]byte(strings.Join(eins, ",")))
+		parts = append(parts, fmt.Sprintf("%d EINs (%s)", len(eins), hex.EncodeToString(sum[:])[:12]))
+	}
+	if f.FromPeriod != "" {
+		parts = append(parts, "tax periods "+f.FromPeriod+"-"+f.ToPeriod)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newFilingIndex creates an empty index
+func newFilingIndex() *FilingIndex {
+	return &FilingIndex{
+		byObject: make(map[string]*FilingRecord),
+		byEIN:    make(map[string][]*FilingRecord),
+	}
+}
+
+// add inserts a record, replacing an earlier record with the same OBJECT_ID
+func (idx *FilingIndex) add(r *FilingRecord) {
+	if existing, ok := idx.byObject[r.ObjectID]; ok {
+		*existing = *r
+		return
+	}
+	idx.Records = append(idx.Records, r)
+	idx.byObject[r.ObjectID] = r
+	idx.byEIN[r.EIN] = append(idx.byEIN[r.EIN], r)
+}
+
+// Lookup returns the filing with the given OBJECT_ID
+func (idx *FilingIndex) Lookup(objectID string) (*FilingRecord, bool) {
+	r, ok := idx.byObject[objectID]
+	return r, ok
+}
+
+// ByEIN returns every filing for an EIN
+func (idx *FilingIndex) ByEIN(ein string) []*FilingRecord {
+	return idx.byEIN[normalizeEIN(ein)]
+}
+
+// Select returns every filing that passes the filter
+func (idx *FilingIndex) Select(filter FilingFilter) []*FilingRecord {
+	if len(filter.EINs) > 0 {
+		var selected []*FilingRecord
+		for ein := range filter.EINs {
+			for _, r := range idx.byEIN[ein] {
+				if filter.Match(r) {
+					selected = append(selected, r)
+				}
+			}
+		}
+		return selected
+	}
+
+	var selected []*FilingRecord
+	for _, r := range idx.Records {
+		if filter.Match(r) {
+			selected = append(selected, r)
+		}
+	}
+	return selected
+}
+
+// SyncIndexFiles downloads the yearly index CSVs listed on the download page,
+// falling back to the conventional URL for each year when none are linked
+func SyncIndexFiles(src Source) error {
+	links, err := pageLinks(src, irsDownloadsPage, ".csv")
+	if err != nil {
+		return err
+	}
+
+	var jobs []DownloadJob
+	seen := make(map[string]bool)
+	for _, link := range links {
+		name := extractFilenameFromURL(link)
+		if indexFilePattern.MatchString(name) && !seen[name] {
+			seen[name] = true
+			jobs = append(jobs, conditionalJob(indexDir, link))
+		}
+	}
+	if len(jobs) == 0 {
+		for year := firstIndexYear; year <= time.Now().Year(); year++ {
+			jobs = append(jobs, conditionalJob(indexDir, fmt.Sprintf("%s%d/index_%d.csv", irsXMLBase, year, year)))
+		}
+	}
+
+	fmt.Printf("Fetching %d index files from %s...\n", len(jobs), src.Name())
+	results := NewDownloader(src, indexDir, defaultDownloadWorkers).Download(jobs)
+
+	var failed int
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+	if failed == len(results) {
+		return fmt.Errorf("failed to download any index files")
+	}
+	return nil
+}
+
+// conditionalJob builds a download job that skips files we already hold in
+// dir unless the source has a newer copy
+func conditionalJob(dir, link string) DownloadJob {
+	job := DownloadJob{URL: link}
+	if info, err := os.Stat(filepath.Join(dir, extractFilenameFromURL(link))); err == nil {
+		job.LastModified = info.ModTime().UTC().Format(http.TimeFormat)
+	}
+	return job
+}
+
+// BuildFilingIndex parses every yearly index file in dir and links the
+// filings to XML files found in the extracted archive directories
+func BuildFilingIndex(dir, zipDir string) (*FilingIndex, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read index directory: %w", err)
+	}
+
+	idx := newFilingIndex()
+	for _, entry := range entries {
+		match := indexFilePattern.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		count, err := idx.parseYearlyIndex(filepath.Join(dir, entry.Name()), match[1])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Printf("Parsed %d filings from %s\n", count, entry.Name())
+	}
+
+	linked, err := idx.LinkArchives(zipDir)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Linked %d of %d filings to archive entries or extracted XML files\n", linked, len(idx.Records))
+
+	return idx, nil
+}
+
+// parseYearlyIndex reads one IRS index_YYYY.csv. Column sets vary between
+// years, so fields are located by header name.
+func (idx *FilingIndex) parseYearlyIndex(path, year string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open index file: %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read header of %s: %w", path, err)
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		columns[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["OBJECT_ID"]; !ok {
+		return 0, fmt.Errorf("index file %s has no OBJECT_ID column", path)
+	}
+
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var count int
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return count, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+
+		objectID := field(row, "OBJECT_ID")
+		if objectID == "" {
+			continue
+		}
+
+		batch := field(row, "XML_BATCH_ID")
+		idx.add(&FilingRecord{
+			ObjectID:       objectID,
+			EIN:            normalizeEIN(field(row, "EIN")),
+			TaxpayerName:   field(row, "TAXPAYER_NAME"),
+			ReturnType:     field(row, "RETURN_TYPE"),
+			TaxPeriod:      field(row, "TAX_PERIOD"),
+			SubmissionDate: field(row, "SUB_DATE"),
+			ReturnID:       field(row, "RETURN_ID"),
+			FilingType:     field(row, "FILING_TYPE"),
+			DLN:            field(row, "DLN"),
+			BatchID:        batch,
+			IndexYear:      year,
+			Archive:        batch,
+		})
+		count++
+	}
+
+	return count, nil
+}
+
+// LinkArchives reads the central directory of every archive and walks the
+// extracted directories, recording where each filing's XML lives. Filings
+// are named <OBJECT_ID>_public.xml. It returns the number of linked filings.
+func (idx *FilingIndex) LinkArchives(zipDir string) (int, error) {
+	entries, err := os.ReadDir(zipDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+			continue
+		}
+
+		reader, err := zip.OpenReader(filepath.Join(zipDir, entry.Name()))
+		if err != nil {
+			fmt.Printf("Skipping unreadable archive %s: %v\n", entry.Name(), err)
+			continue
+		}
+		archive := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		for _, file := range reader.File {
+			if r, ok := idx.byObject[objectIDFromFilename(path.Base(file.Name))]; ok {
+				r.Archive = archive
+				r.Entry = file.Name
+			}
+		}
+		reader.Close()
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		archive := entry.Name()
+		err := filepath.WalkDir(filepath.Join(zipDir, archive), func(path string, d fs.DirEntry, walkErr error) error {
+			if walkErr != nil {
+				return walkErr
+			}
+			if d.IsDir() || !strings.HasSuffix(strings.ToLower(d.Name()), ".xml") {
+				return nil
+			}
+
+			r, ok := idx.byObject[objectIDFromFilename(d.Name())]
+			if !ok {
+				return nil
+			}
+			r.Archive = archive
+			r.XMLPath = path
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to walk %s: %w", archive, err)
+		}
+	}
+
+	var linked int
+	for _, r := range idx.Records {
+		if r.Entry != "" || r.XMLPath != "" {
+			linked++
+		}
+	}
+	return linked, nil
+}
+
+// Save writes the combined index to path
+func (idx *FilingIndex) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create index directory: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to create index file: %w", err)
+	}
+
+	writer := csv.NewWriter(file)
+	writer.Write(filingIndexHeader)
+	for _, r := range idx.Records {
+		writer.Write(r.row())
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write index file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to close index file: %w", err)
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// LoadFilingIndex reads the combined index written by Save
+func LoadFilingIndex(path string) (*FilingIndex, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open filing index (run the index command first): %w", err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read filing index header: %w", err)
+	}
+
+	// Columns are looked up by name so indexes written by older versions still load
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[name] = i
+	}
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return row[i]
+		}
+		return ""
+	}
+
+	idx := newFilingIndex()
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read filing index: %w", err)
+		}
+
+		idx.add(&FilingRecord{
+			ObjectID:       field(row, "OBJECT_ID"),
+			EIN:            field(row, "EIN"),
+			TaxpayerName:   field(row, "TAXPAYER_NAME"),
+			ReturnType:     field(row, "RETURN_TYPE"),
+			TaxPeriod:      field(row, "TAX_PERIOD"),
+			SubmissionDate: field(row, "SUB_DATE"),
+			ReturnID:       field(row, "RETURN_ID"),
+			FilingType:     field(row, "FILING_TYPE"),
+			DLN:            field(row, "DLN"),
+			BatchID:        field(row, "XML_BATCH_ID"),
+			IndexYear:      field(row, "INDEX_YEAR"),
+			Archive:        field(row, "ARCHIVE"),
+			Entry:          field(row, "ENTRY"),
+			XMLPath:        field(row, "XML_PATH"),
+		})
+	}
+
+	return idx, nil
+}
+
+// Summary prints filing counts per index year and return type
+func (idx *FilingIndex) Summary(w io.Writer) {
+	counts := make(map[string]map[string]int)
+	for _, r := range idx.Records {
+		if counts[r.IndexYear] == nil {
+			counts[r.IndexYear] = make(map[string]int)
+		}
+		counts[r.IndexYear][r.ReturnType]++
+	}
+
+	years := make([]string, 0, len(counts))
+	for year := range counts {
+		years = append(years, year)
+	}
+	sort.Strings(years)
+
+	for _, year := range years {
+		types := make([]string, 0, len(counts[year]))
+		for returnType := range counts[year] {
+			types = append(types, returnType)
+		}
+		sort.Strings(types)
+
+		fmt.Fprintf(w, "%s:", year)
+		for _, returnType := range types {
+			fmt.Fprintf(w, " %s=%d", returnType, counts[year][returnType])
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// RebuildFilingIndex fetches the yearly index files from src and writes the combined index
+func RebuildFilingIndex(src Source) error {
+	if err := SyncIndexFiles(src); err != nil {
+		return err
+	}
+
+	idx, err := BuildFilingIndex(indexDir, zipBaseDir)
+	if err != nil {
+		return err
+	}
+	idx.Summary(os.Stdout)
+
+	return idx.Save(filepath.Join(indexDir, filingIndexCSV))
+}
+
+// objectIDFromFilename maps "202401234567890123_public.xml" to its OBJECT_ID
+func objectIDFromFilename(name string) string {
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	return strings.TrimSuffix(name, "_public")
+}
+
+// normalizeEIN strips formatting and restores leading zeros dropped by spreadsheets
+func normalizeEIN(ein string) string {
+	var digits strings.Builder
+	for _, c := range ein {
+		if c >= '0' && c <= '9' {
+			digits.WriteRune(c)
+		}
+	}
+	if digits.Len() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%09s", digits.String())
+}
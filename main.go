@@ -1,15 +1,15 @@
 package main
 
 import (
-    "archive/zip"
     "bufio"
+    "context"
+    "flag"
     "fmt"
-    "io"
     "log"
     "os"
-    "os/exec"
     "path/filepath"
     "strings"
+    "time"
 )
 
 func confirmation(s string, tries int) bool {
@@ -33,26 +33,152 @@ func confirmation(s string, tries int) bool {
     return false
 }
 
+// loadJoins loads the lookup tables selected by the -bmf and -eligibility flags
+func loadJoins(bmf, eligibility bool) ([]EINJoin, error) {
+    var joins []EINJoin
+    if bmf {
+        table, err := LoadBMFTable(filepath.Join(bmfDir, bmfTableFile))
+        if err != nil {
+            return nil, err
+        }
+        joins = append(joins, table)
+    }
+    if eligibility {
+        table, err := LoadEligibilityTable(eligibilityDir)
+        if err != nil {
+            return nil, err
+        }
+        joins = append(joins, table)
+    }
+    return joins, nil
+}
+
 func main() {
     if len(os.Args) < 2 {
         fmt.Println("Nah need a command")
         return
-    } else if len(os.Args) > 2 {
+    }
+
+    flags := flag.NewFlagSet(os.Args[1], flag.ExitOnError)
+    sourceSpec := flags.String("source", "irs", `where to fetch from: "irs", a mirror directory, or a mirror base URL`)
+    storeSpec := flags.String("store", "", `where sync, zips, backfill, unzip, csv, daemon and gc keep archives and output (verify, index and scan need the working directory): empty for the working directory, another directory, or s3://bucket/prefix (credentials and AWS_ENDPOINT_URL from the environment)`)
+    clientConfig := DefaultClientConfig()
+    flags.StringVar(&clientConfig.UserAgent, "user-agent", clientConfig.UserAgent, "User-Agent header sent with every request")
+    flags.Float64Var(&clientConfig.RequestsPerSecond, "rate", clientConfig.RequestsPerSecond, "maximum requests per second to each host (0 for no limit)")
+    flags.IntVar(&clientConfig.MaxRetries, "retries", clientConfig.MaxRetries, "retries for failed requests")
+    flags.DurationVar(&clientConfig.ConnectTimeout, "connect-timeout", clientConfig.ConnectTimeout, "timeout for connecting to a server")
+    flags.DurationVar(&clientConfig.ReadTimeout, "read-timeout", clientConfig.ReadTimeout, "timeout for a stalled response")
+    var eins, returnTypes, einFile, taxPeriods *string
+    var walk, dryRun, force, joinBMF, joinEligibility, deleteExtracted *bool
+    var extractWorkers, taxYear *int
+    var family, states *string
+    var latest, once, skipPreflight, save *bool
+    var keepYears *int
+    var interval *time.Duration
+    var years, months, parts *string
+    var filingPath *string
+    var validate *bool
+    switch os.Args[1] {
+    case "sync", "zips":
+        years = flags.String("year", "", "archive (publication) years to fetch, not tax years, e.g. 2023 or 2021-2023")
+        months = flags.String("month", "", "filing months to fetch, e.g. 5 or 1-6")
+        parts = flags.String("part", "", "archive part letters to fetch, e.g. A,B")
+        dryRun = flags.Bool("dry-run", false, "list the archives in scope without downloading")
+        skipPreflight = flags.Bool("skip-preflight", false, "download even when the free disk space looks too small")
+    case "backfill":
+        years = flags.String("year", "", "archive (publication) years to probe, not tax years, e.g. 2017 or 2015-2019 (required)")
+        months = flags.String("month", "", "filing months to probe, e.g. 5 or 1-6")
+        parts = flags.String("part", "", "archive part letters to download, e.g. A,B")
+        dryRun = flags.Bool("dry-run", false, "probe and list the archives found without downloading")
+        skipPreflight = flags.Bool("skip-preflight", false, "download even when the free disk space looks too small")
+    case "daemon":
+        years = flags.String("year", "", "archive (publication) years to keep current, not tax years, e.g. 2023 or 2021-2023")
+        months = flags.String("month", "", "filing months to keep current, e.g. 5 or 1-6")
+        parts = flags.String("part", "", "archive part letters to keep current, e.g. A,B")
+        interval = flags.Duration("interval", defaultDaemonInterval, "time between checks of the IRS download page")
+        once = flags.Bool("once", false, "run a single cycle and exit")
+        extractWorkers = flags.Int("workers", defaultExtractWorkers, "archives to extract in parallel")
+        joinBMF = flags.Bool("bmf", false, "append EO BMF classification columns joined on EIN (run the bmf command first)")
+        joinEligibility = flags.Bool("eligibility", false, "append Pub 78 and auto-revocation status columns joined on EIN (run the eligibility command first)")
+    case "csv":
+        eins = flags.String("ein", "", "comma separated EINs to select from the filing index")
+        returnTypes = flags.String("return-type", "", "comma separated return types to select from the filing index, e.g. 990,990T")
+        joinBMF = flags.Bool("bmf", false, "append EO BMF classification columns joined on EIN (run the bmf command first)")
+        joinEligibility = flags.Bool("eligibility", false, "append Pub 78 and auto-revocation status columns joined on EIN (run the eligibility command first)")
+    case "epostcard":
+        eins = flags.String("ein", "", "comma separated EINs to import")
+        einFile = flags.String("ein-file", "", "file listing EINs to import, one per line")
+        taxPeriods = flags.String("tax-period", "", "tax period end range to import, e.g. 2023, 2022-2023 or 202207-202306")
+        joinBMF = flags.Bool("bmf", false, "append EO BMF classification columns joined on EIN (run the bmf command first)")
+        joinEligibility = flags.Bool("eligibility", false, "append Pub 78 and auto-revocation status columns joined on EIN (run the eligibility command first)")
+    case "bmf":
+        states = flags.String("state", "", "comma separated state codes to fetch per-state files instead of the regional extract, e.g. CA,NY")
+    case "scan":
+        eins = flags.String("ein", defaultScanEIN, "EIN to search for")
+        walk = flags.Bool("walk", false, "scan every extracted XML file instead of using the filing index")
+    case "schemas":
+        taxYear = flags.Int("tax-year", 0, "list the registered schema versions that apply to a tax year instead of downloading")
+        latest = flags.Bool("latest", false, "show the latest registered schema version instead of downloading")
+        family = flags.String("family", "", "form family for -tax-year and -latest, e.g. 990 or 990T")
+    case "unzip":
+        extractWorkers = flags.Int("workers", defaultExtractWorkers, "archives to extract in parallel")
+        force = flags.Bool("force", false, "re-extract archives even if they are unchanged since the last extraction")
+        eins = flags.String("ein", "", "comma separated EINs to extract")
+        einFile = flags.String("ein-file", "", "file listing EINs to extract, one per line")
+        returnTypes = flags.String("return-type", "", "comma separated return types to extract, e.g. 990,990T")
+        taxPeriods = flags.String("tax-period", "", "tax period end range to extract, e.g. 2023, 2022-2023 or 202207-202306")
+        skipPreflight = flags.Bool("skip-preflight", false, "extract even when the free disk space looks too small")
+    case "decode":
+        filingPath = flags.String("file", "", "filing XML to decode into the models of its returnVersion")
+        validate = flags.Bool("validate", false, "check the filing against the facets and required elements of its schemas")
+    case "gc":
+        keepYears = flags.Int("keep-years", 0, "keep only the archives of the latest N years, counting the current one (0 keeps every year)")
+        deleteExtracted = flags.Bool("delete-extracted", false, "delete extracted XML of archives whose own CSV from the daemon is newer than the archive")
+        dryRun = flags.Bool("dry-run", false, "list what would be deleted without deleting it")
+        save = flags.Bool("save", false, "save the policy given by the flags to ./data/retention.json, where sync, backfill and daemon also honor it")
+    }
+    flags.Parse(os.Args[2:])
+    if flags.NArg() > 0 {
         fmt.Println("too many")
-        return 
+        return
     }
 
+    source, err := ParseSource(*sourceSpec, NewClient(clientConfig))
+    if err != nil {
+        fmt.Println(err)
+        return
+    }
 
+    store, err := ParseStorage(*storeSpec)
+    if err != nil {
+        fmt.Println(err)
+        return
+    }
+
+    var archiveFilter ArchiveFilter
+    if years != nil {
+        archiveFilter, err = ParseArchiveFilter(*years, *months, *parts)
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+    }
 
     switch os.Args[1] {
     case "zips":
+        if *dryRun {
+            if _, err := CheckAndDownloadMissingZips(context.Background(), source, SyncOptions{Filter: archiveFilter, DryRun: true, Store: store}); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+            break
+        }
         proceed := confirmation(`
-        This will delete any and all zip files in the ./data/990_zips directory.
-        Do not proceed with this command if you have already used it.
+        This will download every zip file in scope again, replacing the copies already stored.
+        Use sync to fetch only the missing and changed ones.
 
         `, 3)
         if proceed {
-            zips, err := UnpackZips()
+            zips, err := UnpackZips(source, store, archiveFilter)
             if err != nil {
                 fmt.Println(err)
             }
@@ -63,13 +189,20 @@ func main() {
         break
 
     case "sync":
+        if *dryRun {
+            if _, err := CheckAndDownloadMissingZips(context.Background(), source, SyncOptions{Filter: archiveFilter, DryRun: true, Store: store}); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+            break
+        }
         proceed := confirmation(`
-        This will check what zip files are already downloaded and download only the missing ones.
+        This will check what zip files are already downloaded and download only the missing ones,
+        re-fetching any archive the IRS has republished since the last sync.
         This is safe to run multiple times.
         
         `, 3)
         if proceed {
-            if err := CheckAndDownloadMissingZips(); err != nil {
+            if _, err := CheckAndDownloadMissingZips(context.Background(), source, SyncOptions{Filter: archiveFilter, Store: store, SkipPreflight: *skipPreflight}); err != nil {
                 fmt.Printf("Error: %v\n", err)
             } else {
                 fmt.Println("Sync complete!")
@@ -80,21 +213,55 @@ func main() {
         break
 
     case "schemas":
-        versions, err := UnpackSchemas()
-        if err != nil {
-            fmt.Println(err)
+        if *taxYear != 0 || *latest {
+            registry, err := LoadSchemaRegistry(schemaRegistryPath)
+            if err != nil {
+                fmt.Println(err)
+                return
+            }
+            var versions []*SchemaVersion
+            switch {
+            case *taxYear != 0 && *latest:
+                if v := registry.LatestForTaxYear(*taxYear, *family); v != nil {
+                    versions = append(versions, v)
+                }
+            case *taxYear != 0:
+                versions = registry.ForTaxYear(*taxYear, *family)
+            default:
+                if v := registry.Latest(*family); v != nil {
+                    versions = append(versions, v)
+                }
+            }
+            PrintSchemaVersions(os.Stdout, versions)
+            break
         }
-        links := generateLinks(versions) 
-        fmt.Println(links)
-        UnzipSchemas()
-        files, err := GlobWalk("./data/990_xsd/output", "*.xsd")
+
+        registry, err := UnpackSchemas(source)
         if err != nil {
             fmt.Println(err)
         }
-        fmt.Println(files) 
+        if registry == nil {
+            break
+        }
+        PrintSchemaVersions(os.Stdout, registry.Versions)
 
-        cmd := exec.Command("bash", "chmod x+a ./models.sh; ./models.sh")
-        if err := cmd.Run(); err != nil {
+        // Every cached version gets its own models, so filings decode against the schemas they were filed under
+        for _, v := range registry.Versions {
+            if !v.Cached() {
+                continue
+            }
+            if err := os.RemoveAll(v.TemplatesDir()); err != nil {
+                fmt.Println(err)
+                continue
+            }
+            files, err := GlobWalk(v.CacheDir(), "*.xsd", v.TemplatesDir())
+            if err != nil {
+                fmt.Println(err)
+            }
+            fmt.Printf("Converted %d schemas of %s\n", len(files), v.Version)
+        }
+
+        if err := regenerateModels(); err != nil {
             fmt.Println("pipeline failed to run", err)
         } else {
             log.Println("Completed pipeline collapse")
@@ -102,14 +269,33 @@ func main() {
 
         break
 
+    case "models":
+        if err := regenerateModels(); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
+
     case "unzip":
+        filter := ParseFilingFilter(*eins, *returnTypes)
+        if *einFile != "" {
+            if err := filter.LoadEINFile(*einFile); err != nil {
+                fmt.Println(err)
+                return
+            }
+        }
+        if err := filter.SetTaxPeriods(*taxPeriods); err != nil {
+            fmt.Println(err)
+            return
+        }
         proceed := confirmation(`
         This will extract all ZIP files in the ./data/990_zips directory.
-        Each ZIP file will be extracted to its own directory.
+        Each ZIP file will be extracted to its own directory; archives that are
+        unchanged since their last extraction are skipped. With -ein, -ein-file,
+        -return-type or -tax-period only the matching filings are written.
         
         `, 3)
         if proceed {
-            if err := ExtractAllZips(); err != nil {
+            if _, err := ExtractAllZips(context.Background(), ExtractOptions{Workers: *extractWorkers, Force: *force, Filter: filter, Store: store, SkipPreflight: *skipPreflight}); err != nil {
                 fmt.Printf("Error: %v\n", err)
             } else {
                 fmt.Println("Unzip complete!")
@@ -119,16 +305,60 @@ func main() {
         }
         break
 
+    case "backfill":
+        if *dryRun {
+            if _, err := Backfill(source, BackfillOptions{Filter: archiveFilter, DryRun: true, Store: store}); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+            break
+        }
+        proceed := confirmation(`
+        This will probe every archive name the IRS has used with HEAD requests,
+        record which exist in ./data/backfill.json and download any that are
+        missing or changed. Archives found by earlier backfills are not probed again.
+
+        `, 3)
+        if proceed {
+            if _, err := Backfill(source, BackfillOptions{Filter: archiveFilter, Store: store, SkipPreflight: *skipPreflight}); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+        } else {
+            fmt.Println("Aborting")
+        }
+        break
+
+    case "daemon":
+        if !*once && *interval <= 0 {
+            fmt.Println("-interval must be positive")
+            return
+        }
+        joins, err := loadJoins(*joinBMF, *joinEligibility)
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+        opts := DaemonOptions{Interval: *interval, Once: *once, Filter: archiveFilter, Workers: *extractWorkers, Joins: joins, Store: store}
+        if err := RunDaemon(source, opts); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
+
     case "csv":
         proceed := confirmation(`
-        This will process all XML files in the ./data/990_zips directories
+        This will process all XML filings in the ./data/990_zips archives, reading
+        them straight from the zip files (running unzip first is not required),
         and create a comprehensive CSV file with IRS Form 990 data.
         
         Output file: irs_990_data.csv
         
         `, 3)
         if proceed {
-            if err := ProcessAllDirectories(); err != nil {
+            joins, err := loadJoins(*joinBMF, *joinEligibility)
+            if err != nil {
+                fmt.Println(err)
+                return
+            }
+            if err := ProcessAllDirectories(store, ParseFilingFilter(*eins, *returnTypes), joins...); err != nil {
                 fmt.Printf("Error: %v\n", err)
             } else {
                 fmt.Println("CSV generation complete! Check irs_990_data.csv")
@@ -138,115 +368,153 @@ func main() {
         }
         break
 
-    default:
-        fmt.Println("the argument provided doesn't exist")
-    }
-}
+    case "epostcard":
+        filter := ParseFilingFilter(*eins, "")
+        if *einFile != "" {
+            if err := filter.LoadEINFile(*einFile); err != nil {
+                fmt.Println(err)
+                return
+            }
+        }
+        if err := filter.SetTaxPeriods(*taxPeriods); err != nil {
+            fmt.Println(err)
+            return
+        }
+        joins, err := loadJoins(*joinBMF, *joinEligibility)
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+        if err := ImportEPostcards(source, filter, joins...); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        } else {
+            fmt.Println("990-N import complete! Check", ePostcardCSV)
+        }
+        break
 
-func generateLinks(versions map[string]Version) []string {
-    var links []string  
+    case "bmf":
+        if err := RebuildBMF(source, strings.Split(*states, ",")); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
 
-    for _, version := range versions {
-        links = append(links, fmt.Sprintf(`https://www.irs.gov/pub/irs-tege/%s`, version.Schedule))
-    }
+    case "eligibility":
+        if err := RebuildEligibility(source); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
 
-    return links
-}
+    case "verify":
+        if err := requireLocalStore("verify", store); err != nil {
+            fmt.Println(err)
+            break
+        }
+        if err := VerifyAllZips("./data/990_zips"); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
 
-// ExtractAllZips extracts all ZIP files in the data/990_zips directory
-func ExtractAllZips() error {
-    zipDir := "./data/990_zips"
-    
-    // Read all files in the directory
-    entries, err := os.ReadDir(zipDir)
-    if err != nil {
-        return fmt.Errorf("failed to read directory: %w", err)
-    }
-    
-    var extractedCount int
-    for _, entry := range entries {
-        if entry.IsDir() || !strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
-            continue
+    case "index":
+        if err := requireLocalStore("index", store); err != nil {
+            fmt.Println(err)
+            break
         }
-        
-        zipPath := filepath.Join(zipDir, entry.Name())
-        extractDir := filepath.Join(zipDir, strings.TrimSuffix(entry.Name(), ".zip"))
-        
-        fmt.Printf("Extracting %s to %s...\n", entry.Name(), extractDir)
-        
-        if err := extractZip(zipPath, extractDir); err != nil {
-            fmt.Printf("Error extracting %s: %v\n", entry.Name(), err)
-            continue
+        if err := RebuildFilingIndex(source); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        } else {
+            fmt.Println("Filing index written to", filepath.Join(indexDir, filingIndexCSV))
         }
-        
-        extractedCount++
-        fmt.Printf("✓ Successfully extracted %s\n", entry.Name())
-    }
-    
-    fmt.Printf("Extraction complete! Extracted %d ZIP files.\n", extractedCount)
-    return nil
-}
+        break
 
-// extractZip extracts a single ZIP file to the specified directory
-func extractZip(zipPath, extractDir string) error {
-    // Open the ZIP file
-    reader, err := zip.OpenReader(zipPath)
-    if err != nil {
-        return fmt.Errorf("failed to open ZIP file: %w", err)
-    }
-    defer reader.Close()
-    
-    // Create the extraction directory
-    if err := os.MkdirAll(extractDir, 0755); err != nil {
-        return fmt.Errorf("failed to create extraction directory: %w", err)
-    }
-    
-    // Extract each file in the ZIP
-    for _, file := range reader.File {
-        filePath := filepath.Join(extractDir, file.Name)
-        
-        // Check for path traversal
-        if !strings.HasPrefix(filePath, filepath.Clean(extractDir)+string(os.PathSeparator)) {
-            return fmt.Errorf("illegal file path: %s", filePath)
+    case "scan":
+        if err := requireLocalStore("scan", store); err != nil {
+            fmt.Println(err)
+            break
         }
-        
-        if file.FileInfo().IsDir() {
-            // Create directory
-            if err := os.MkdirAll(filePath, file.Mode()); err != nil {
-                return fmt.Errorf("failed to create directory: %w", err)
+        if err := ScanAllEINs(*eins, *walk); err != nil {
+            fmt.Printf("Error: %v\n", err)
+        }
+        break
+
+    case "gc":
+        policy, err := LoadRetentionPolicy(retentionPath)
+        if err != nil {
+            fmt.Println(err)
+            return
+        }
+        // Flags given on the command line override the saved policy
+        flags.Visit(func(f *flag.Flag) {
+            switch f.Name {
+            case "keep-years":
+                policy.KeepYears = *keepYears
+            case "delete-extracted":
+                policy.DeleteExtracted = *deleteExtracted
             }
-            continue
+        })
+        if policy.KeepYears < 0 {
+            fmt.Println("-keep-years must not be negative")
+            return
         }
-        
-        // Create parent directories for the file
-        if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
-            return fmt.Errorf("failed to create parent directories: %w", err)
+        if *save {
+            if err := policy.Save(retentionPath); err != nil {
+                fmt.Println(err)
+                return
+            }
+            fmt.Printf("Saved retention policy to %s\n", retentionPath)
         }
-        
-        // Open the file in the ZIP
-        zipFile, err := file.Open()
+        if *dryRun {
+            if err := RunGC(store, policy, true); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+            break
+        }
+        proceed := confirmation(`
+        This will permanently delete the raw data the retention policy does not keep.
+        Run with -dry-run first to see what that is.
+
+        `, 3)
+        if proceed {
+            if err := RunGC(store, policy, false); err != nil {
+                fmt.Printf("Error: %v\n", err)
+            }
+        } else {
+            fmt.Println("Aborting")
+        }
+        break
+
+    case "decode":
+        file, err := os.Open(*filingPath)
         if err != nil {
-            return fmt.Errorf("failed to open file in ZIP: %w", err)
+            fmt.Printf("Error: %v\n", err)
+            return
         }
-        
-        // Create the output file
-        outputFile, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, file.Mode())
+        filing, err := DecodeFiling(file)
+        file.Close()
         if err != nil {
-            zipFile.Close()
-            return fmt.Errorf("failed to create output file: %w", err)
+            fmt.Printf("Error: %v\n", err)
+            return
         }
-        
-        // Copy the file contents
-        if _, err := io.Copy(outputFile, zipFile); err != nil {
-            zipFile.Close()
-            outputFile.Close()
-            return fmt.Errorf("failed to copy file contents: %w", err)
+        filing.Print(os.Stdout)
+        if *validate {
+            if err := filing.Validate(); err != nil {
+                fmt.Printf("Invalid:\n%v\n", err)
+            } else {
+                fmt.Println("Valid")
+            }
         }
-        
-        zipFile.Close()
-        outputFile.Close()
+        break
+
+    case "changes":
+        queue, err := LoadReprocessQueue(reprocessPath)
+        if err != nil {
+            fmt.Printf("Error: %v\n", err)
+            return
+        }
+        queue.Print(os.Stdout)
+        fmt.Printf("Every filing change is logged in %s\n", filingChangeLog)
+        break
+
+    default:
+        fmt.Println("the argument provided doesn't exist")
     }
-    
-    return nil
 }
-
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"maps"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// withEnumTypes names a Go type for each element and attribute that restricts
+// a string to an enumeration in place, such as ReturnTypeCd, and returns a
+// copy of f giving each of those types the element's facets, with the names
+// by element name and @attribute. A name some type already has gets an Enum
+// suffix.
+func (f *SchemaFacets) withEnumTypes(documents []*modelDocument) (*SchemaFacets, map[string]string) {
+	if f == nil {
+		return nil, nil
+	}
+	taken := make(map[string]bool)
+	for name := range f.Types {
+		taken[name] = true
+	}
+	for _, document := range documents {
+		for _, t := range document.Types {
+			taken[t.Name] = true
+		}
+	}
+
+	keys := make([]string, 0, len(f.Elements))
+	for key, facets := range f.Elements {
+		if len(facets.Enumeration) > 0 {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	withEnums := &SchemaFacets{Types: maps.Clone(f.Types), Elements: f.Elements, Documents: f.Documents}
+	enums := make(map[string]string)
+	for _, key := range keys {
+		name := xsd2goName(strings.TrimPrefix(key, "@"))
+		if name == "" {
+			continue
+		}
+		for taken[name] {
+			name += "Enum"
+		}
+		taken[name] = true
+		enums[key] = name
+		withEnums.Types[name] = f.Elements[key]
+	}
+	return withEnums, enums
+}
+
+// retypeEnumFields gives the string fields of enumerated elements and
+// attributes their enumeration type, recording the types it used
+func retypeEnumFields(spec *ast.TypeSpec, enums map[string]string, used map[string]bool) {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok || len(enums) == 0 {
+		return
+	}
+	for _, field := range st.Fields.List {
+		if field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		name, flags, _ := strings.Cut(reflect.StructTag(tag).Get("xml"), ",")
+		if strings.Contains(flags, "attr") {
+			name = "@" + name
+		}
+		enum, ok := enums[name]
+		if !ok {
+			continue
+		}
+		if ident := enumFieldIdent(field.Type); ident != nil && ident.Name == "string" {
+			ident.Name = enum
+			used[enum] = true
+		}
+	}
+}
+
+// enumFieldIdent finds the type name of a plain, pointer or slice field
+func enumFieldIdent(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.StarExpr:
+		return enumFieldIdent(e.X)
+	case *ast.ArrayType:
+		if e.Len == nil {
+			return enumFieldIdent(e.Elt)
+		}
+	}
+	return nil
+}
+
+// enumModelTypes declares the enumeration types in used
+func enumModelTypes(used map[string]bool, enums map[string]string) []*modelType {
+	var types []*modelType
+	for key, name := range enums {
+		if !used[name] {
+			continue
+		}
+		kind := "element"
+		if attr, ok := strings.CutPrefix(key, "@"); ok {
+			key, kind = attr, "attribute"
+		}
+		types = append(types, &modelType{
+			Name: name,
+			Doc:  fmt.Sprintf("%s holds the values the schema allows for the %s %s", name, key, kind),
+			Spec: &ast.TypeSpec{Name: ast.NewIdent(name), Type: ast.NewIdent("string")},
+		})
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Name < types[j].Name })
+	return types
+}
+
+// writeEnum declares a constant for each value of a string type restricted
+// to an enumeration, with IsValid and a Label lookup. It reports whether the
+// type has an enumeration.
+func (w *validateWriter) writeEnum(body *bytes.Buffer, t *modelType) bool {
+	kind, ok := t.Spec.Type.(*ast.Ident)
+	if !ok || kind.Name != "string" {
+		return false
+	}
+	facets := w.scope.facets.typeFacets(t.Name)
+	if facets == nil || len(facets.Enumeration) == 0 {
+		return false
+	}
+
+	constants := make([]string, len(facets.Enumeration))
+	named := make(map[string]bool)
+	for i, value := range facets.Enumeration {
+		constant := enumConstName(t.Name, value)
+		if _, clash := w.scope.kinds[constant]; clash || named[constant] {
+			constant = fmt.Sprintf("%s%d", t.Name, i+1)
+		}
+		named[constant] = true
+		constants[i] = constant
+	}
+
+	fmt.Fprintf(body, "// %s values\nconst (\n", t.Name)
+	for i, value := range facets.Enumeration {
+		fmt.Fprintf(body, "\t%s %s = %q\n", constants[i], t.Name, value)
+	}
+	body.WriteString(")\n\n")
+
+	labels := lowerFirst(t.Name) + "Labels"
+	fmt.Fprintf(body, "var %s = map[%s]string{\n", labels, t.Name)
+	for i, value := range facets.Enumeration {
+		label := value
+		if doc := facets.Labels[value]; doc != "" {
+			label = doc
+		}
+		fmt.Fprintf(body, "\t%s: %q,\n", constants[i], label)
+	}
+	body.WriteString("}\n\n")
+
+	fmt.Fprintf(body, "// IsValid reports whether the value is one the schema allows\n")
+	fmt.Fprintf(body, "func (v %s) IsValid() bool {\n\t_, ok := %s[v]\n\treturn ok\n}\n\n", t.Name, labels)
+	fmt.Fprintf(body, "// Label describes the value as the schema documents it, or returns the\n// value itself when the schema does not\n")
+	fmt.Fprintf(body, "func (v %s) Label() string {\n\tif label, ok := %s[v]; ok {\n\t\treturn label\n\t}\n\treturn string(v)\n}\n\n", t.Name, labels)
+	w.Enums++
+	return true
+}
+
+// enumConstName names the constant for one value, such as ReturnTypeCd990EZ
+// or SignatureOptionCdBinaryAttachment8453SignatureDocument
+func enumConstName(typeName, value string) string {
+	var name strings.Builder
+	name.WriteString(typeName)
+	upperNext := true
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperNext = true
+			continue
+		}
+		if upperNext {
+			r = unicode.ToUpper(r)
+			upperNext = false
+		}
+		name.WriteRune(r)
+	}
+	if name.Len() == len(typeName) {
+		name.WriteString("Empty")
+	}
+	return name.String()
+}
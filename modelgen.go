@@ -0,0 +1,658 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	modelsDir        = "./models"
+	modelsImportPath = "github.com/synergos-systems/models"
+	// commonModelsPackage holds the types every document shares, such as
+	// UsaddressType and BusinessNameType
+	commonModelsPackage = "common"
+	modelsHeader        = "// Code generated by https://github.com/gocomply/xsd2go and the schemas command; DO NOT EDIT.\n"
+	// modelIndexHeader starts the files that list the generated models
+	modelIndexHeader = "// Code generated by the schemas command; DO NOT EDIT.\n"
+	// modelVersionsPackage lists the generated schema versions for DecodeFiling
+	modelVersionsPackage = "versions"
+)
+
+// modelDocument is the xsd2go output for one IRS schema document
+type modelDocument struct {
+	Name    string
+	Package string
+	// Comment is the generator's description of the document, such as its namespace
+	Comment string
+	// Types are the type declarations in source order, one per name
+	Types []*modelType
+	// byName indexes Types
+	byName map[string]*modelType
+}
+
+// modelType is one generated type declaration
+type modelType struct {
+	Name string
+	Doc  string
+	Spec *ast.TypeSpec
+	// Element is set for the types of a document's elements, as opposed to
+	// the complex and simple types elements are built from
+	Element bool
+	// Source is the declaration printed without comments, used to tell
+	// whether two documents declare a type the same way
+	Source string
+	// Scalar is set for the simple types declared over a type in package xsd
+	Scalar *modelScalar
+}
+
+// ModelReport summarizes a GenerateModels run
+type ModelReport struct {
+	Dir        string
+	Documents  int
+	Common     int
+	Duplicates int
+	Skipped    []string
+	// Facets counts the types checked against schema facets, and
+	// DroppedPatterns the patterns Go's regexp cannot run
+	Facets          int
+	DroppedPatterns []string
+	// Enums counts the string types given a constant for each value they allow
+	Enums int
+	// Roots maps each document to its top-level elements, XML name to Go type
+	Roots map[string]map[string]string
+}
+
+// GenerateModels turns the xsd2go output under srcRoot, one directory per
+// schema document holding a single Go file, into importable packages under
+// dstRoot, whose import path is importPath: <Document>/<Document>.go for each
+// document, and common/common.go for the types that several documents declare
+// identically. References to shared types are qualified with the common
+// package. xsd2go sometimes declares a type twice in one document; the
+// repeats are dropped. Documents that are empty or do not parse are skipped
+// and reported. documents.go in dstRoot lists the top-level elements of every
+// document for DecodeFiling. Every type gets a Validate method checking the
+// facets in facets, which may be nil, and the elements the schema requires.
+// Types restricted to an enumeration get a constant for each value; string
+// elements and attributes restricting their values in place are given such a
+// type, named after them. Checkboxes, booleans, dates, timestamps, years and
+// amounts are declared over the types in package xsd that read and write them
+// as filings spell them.
+func GenerateModels(srcRoot, dstRoot, importPath string, facets *SchemaFacets) (*ModelReport, error) {
+	report := &ModelReport{Dir: dstRoot, Roots: make(map[string]map[string]string)}
+	fset := token.NewFileSet()
+
+	entries, err := os.ReadDir(srcRoot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read generated models: %w", err)
+	}
+
+	var documents []*modelDocument
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == commonModelsPackage {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(srcRoot, entry.Name(), "*.go"))
+		if err != nil || len(files) != 1 {
+			report.Skipped = append(report.Skipped, entry.Name())
+			continue
+		}
+
+		document, duplicates, err := parseModelDocument(fset, entry.Name(), files[0])
+		if err != nil {
+			fmt.Printf("Skipping %s: %v\n", entry.Name(), err)
+			report.Skipped = append(report.Skipped, entry.Name())
+			continue
+		}
+		report.Duplicates += duplicates
+		documents = append(documents, document)
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("no generated models found in %s", srcRoot)
+	}
+
+	common := commonModelTypes(documents)
+	report.Common = len(common)
+	applyModelScalars(documents, facets)
+	sharedFacets, enums := facets.withEnumTypes(documents)
+
+	// Write everything next to dstRoot first, so a failure leaves the old models in place
+	staging := dstRoot + ".tmp"
+	if err := os.RemoveAll(staging); err != nil {
+		return nil, fmt.Errorf("failed to clear %s: %w", staging, err)
+	}
+
+	var shared []*modelType
+	seen := make(map[string]bool)
+	for _, document := range documents {
+		for _, t := range document.Types {
+			if common[t.Name] && !seen[t.Name] {
+				seen[t.Name] = true
+				shared = append(shared, t)
+			}
+		}
+	}
+	sort.Slice(shared, func(i, j int) bool { return shared[i].Name < shared[j].Name })
+	usedEnums := make(map[string]bool)
+	for _, t := range shared {
+		retypeEnumFields(t.Spec, enums, usedEnums)
+	}
+	shared = append(shared, enumModelTypes(usedEnums, enums)...)
+	sharedScope := &modelScope{kinds: make(map[string]string), elements: make(map[string]string), facets: sharedFacets}
+	modelKinds(shared, sharedScope.kinds)
+	modelElements(shared, sharedScope.elements)
+
+	validate := newValidateWriter(sharedScope)
+	if err := writeModelPackage(fset, filepath.Join(staging, commonModelsPackage), commonModelsPackage, commonModelsPackage,
+		"// Package common holds the types shared by the IRS e-file schema documents", importPath, shared, nil, validate); err != nil {
+		return nil, err
+	}
+	report.Facets += validate.Facets
+	report.Enums += validate.Enums
+	report.DroppedPatterns = append(report.DroppedPatterns, validate.Dropped...)
+
+	for _, document := range documents {
+		if roots := modelRoots(document); len(roots) > 0 {
+			report.Roots[document.Name] = roots
+		}
+		// Inline restrictions of this document's own apply to its types only
+		localFacets, enums := sharedFacets, enums
+		if own := facets.forDocument(document.Name); own != facets {
+			localFacets, enums = own.withEnumTypes(documents)
+		}
+		var local []*modelType
+		usedEnums := make(map[string]bool)
+		for _, t := range document.Types {
+			if common[t.Name] {
+				continue
+			}
+			retypeEnumFields(t.Spec, enums, usedEnums)
+			qualifyModelType(t.Spec, common)
+			local = append(local, t)
+		}
+		local = append(local, enumModelTypes(usedEnums, enums)...)
+		scope := &modelScope{kinds: maps.Clone(sharedScope.kinds), elements: maps.Clone(sharedScope.elements), facets: localFacets}
+		modelKinds(local, scope.kinds)
+		modelElements(local, scope.elements)
+
+		validate := newValidateWriter(scope)
+		if err := writeModelPackage(fset, filepath.Join(staging, document.Name), document.Name, document.Package, document.Comment, importPath, local, common, validate); err != nil {
+			return nil, err
+		}
+		report.Facets += validate.Facets
+		report.Enums += validate.Enums
+		report.DroppedPatterns = append(report.DroppedPatterns, validate.Dropped...)
+		report.Documents++
+	}
+	if err := writeModelDocuments(filepath.Join(staging, "documents.go"), modelPackageName(filepath.Base(dstRoot)), importPath, documents, report.Roots); err != nil {
+		return nil, err
+	}
+
+	if err := os.RemoveAll(dstRoot); err != nil {
+		return nil, fmt.Errorf("failed to clear %s: %w", dstRoot, err)
+	}
+	if err := os.Rename(staging, dstRoot); err != nil {
+		return nil, fmt.Errorf("failed to replace %s: %w", dstRoot, err)
+	}
+	return report, nil
+}
+
+// Print writes a summary of the run
+func (r *ModelReport) Print(w io.Writer) {
+	fmt.Fprintf(w, "Wrote %d model packages and %d shared types to %s, dropping %d repeated declarations\n",
+		r.Documents, r.Common, r.Dir, r.Duplicates)
+	if len(r.Skipped) > 0 {
+		fmt.Fprintf(w, "Skipped %d documents: %s\n", len(r.Skipped), strings.Join(r.Skipped, ", "))
+	}
+	fmt.Fprintf(w, "%d types are checked against schema facets, %d with constants for their enumeration\n", r.Facets, r.Enums)
+	if len(r.DroppedPatterns) > 0 {
+		fmt.Fprintf(w, "Not checking %d patterns Go cannot run: %s\n", len(r.DroppedPatterns), strings.Join(r.DroppedPatterns, ", "))
+	}
+}
+
+// regenerateModels rebuilds ./models from the xsd2go output of every cached
+// schema version: a version-scoped tree such as models/v2023_4_0 for each,
+// and models/versions listing them
+func regenerateModels() error {
+	registry, err := LoadSchemaRegistry(schemaRegistryPath)
+	if err != nil {
+		return err
+	}
+
+	// Build the whole tree next to ./models, so a failure leaves the old models in place
+	staging := modelsDir + ".tmp"
+	if err := os.RemoveAll(staging); err != nil {
+		return fmt.Errorf("failed to clear %s: %w", staging, err)
+	}
+
+	var generated []*SchemaVersion
+	var reports []*ModelReport
+	// Filings of the versions left out decode with the nearest generated one
+	var missing []string
+	for _, v := range registry.Versions {
+		if !v.Cached() {
+			missing = append(missing, v.Version+" (not cached)")
+			continue
+		}
+		if _, err := os.Stat(v.TemplatesDir()); err != nil {
+			missing = append(missing, v.Version+" (not converted)")
+			continue
+		}
+		facets, err := LoadSchemaFacets(v.CacheDir())
+		if err != nil {
+			return fmt.Errorf("failed to read the facets of %s: %w", v.Version, err)
+		}
+		dir := modelVersionDir(v)
+		report, err := GenerateModels(v.TemplatesDir(), filepath.Join(staging, dir), modelsImportPath+"/"+dir, facets)
+		if err != nil {
+			return fmt.Errorf("failed to generate models for %s: %w", v.Version, err)
+		}
+		report.Dir = filepath.Join(modelsDir, dir)
+		generated = append(generated, v)
+		reports = append(reports, report)
+	}
+	if len(generated) == 0 {
+		return errors.New("no cached schema version has xsd2go output to generate models from; run the schemas command")
+	}
+	if err := writeModelVersions(filepath.Join(staging, modelVersionsPackage, modelVersionsPackage+".go"), generated); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(modelsDir); err != nil {
+		return fmt.Errorf("failed to clear %s: %w", modelsDir, err)
+	}
+	if err := os.Rename(staging, modelsDir); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", modelsDir, err)
+	}
+	for _, report := range reports {
+		report.Print(os.Stdout)
+	}
+	if len(missing) > 0 {
+		fmt.Printf("No models for %d registered versions, whose filings decode with the nearest generated version: %s\n", len(missing), strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// modelVersionDir names the models directory of a schema version:
+// v2023_4_0 for the 990 series, v2023_4_0_990T otherwise
+func modelVersionDir(v *SchemaVersion) string {
+	dir := fmt.Sprintf("v%d_%d_%d", v.Year, v.Major, v.Minor)
+	if v.Family != defaultSchemaFamily {
+		dir += "_" + v.Family
+	}
+	return dir
+}
+
+// parseModelDocument reads one xsd2go output file, keeping the first
+// declaration of each type and counting the repeats dropped
+func parseModelDocument(fset *token.FileSet, name, path string) (*modelDocument, int, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if len(bytes.TrimSpace(src)) == 0 {
+		return nil, 0, errors.New("xsd2go wrote an empty file")
+	}
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	document := &modelDocument{Name: name, Package: modelPackageName(name), byName: make(map[string]*modelType)}
+	if file.Doc != nil {
+		for _, comment := range file.Doc.List {
+			if !strings.HasPrefix(comment.Text, "// Code generated") {
+				document.Comment += comment.Text + "\n"
+			}
+		}
+		document.Comment = strings.TrimSuffix(document.Comment, "\n")
+	}
+
+	var duplicates int
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			spec := spec.(*ast.TypeSpec)
+			t := &modelType{Name: spec.Name.Name, Spec: spec, Source: printModelNode(fset, spec)}
+			if gen.Doc != nil && len(gen.Specs) == 1 {
+				t.Doc = strings.TrimSpace(gen.Doc.Text())
+			}
+			t.Element = t.Doc == "Element"
+
+			if previous, ok := document.byName[t.Name]; ok {
+				if previous.Source != t.Source {
+					return nil, 0, fmt.Errorf("type %s is declared twice with different fields", t.Name)
+				}
+				duplicates++
+				continue
+			}
+			document.byName[t.Name] = t
+			document.Types = append(document.Types, t)
+		}
+	}
+	return document, duplicates, nil
+}
+
+// modelPackageName turns a document name into a package name. Document names
+// such as 50YearADSDeductionStatement are not identifiers, so those get a
+// "doc" prefix; the directory keeps the document name.
+func modelPackageName(document string) string {
+	var name strings.Builder
+	for _, r := range document {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			name.WriteRune(r)
+		}
+	}
+	if name.Len() == 0 || !unicode.IsLetter([]rune(name.String())[0]) {
+		return "doc" + name.String()
+	}
+	return name.String()
+}
+
+// commonModelTypes picks the types to share: those declared by more than one
+// document, identically in all of them, and referring only to other shared
+// types. Elements stay with their documents, so a document that includes
+// another's elements keeps its own copy of them.
+func commonModelTypes(documents []*modelDocument) map[string]bool {
+	sources := make(map[string]map[string]bool)
+	declared := make(map[string]int)
+	elements := make(map[string]bool)
+	for _, document := range documents {
+		for _, t := range document.Types {
+			if t.Element {
+				elements[t.Name] = true
+			}
+			if sources[t.Name] == nil {
+				sources[t.Name] = make(map[string]bool)
+			}
+			sources[t.Name][t.Source] = true
+			declared[t.Name]++
+		}
+	}
+
+	common := make(map[string]bool)
+	for name, variants := range sources {
+		if declared[name] > 1 && len(variants) == 1 && !elements[name] {
+			common[name] = true
+		}
+	}
+
+	// A shared type that refers to a type one document declares differently
+	// would not compile in the common package, so drop it until nothing changes
+	for changed := true; changed; {
+		changed = false
+		for _, document := range documents {
+			for _, t := range document.Types {
+				if !common[t.Name] {
+					continue
+				}
+				for _, ref := range modelTypeRefs(t.Spec.Type) {
+					if _, local := document.byName[ref]; local && !common[ref] {
+						delete(common, t.Name)
+						changed = true
+						break
+					}
+				}
+			}
+		}
+	}
+	return common
+}
+
+// modelTypeRefs lists the unqualified type names an expression refers to
+func modelTypeRefs(expr ast.Expr) []string {
+	var refs []string
+	var walk func(ast.Expr)
+	walk = func(expr ast.Expr) {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			refs = append(refs, e.Name)
+		case *ast.StarExpr:
+			walk(e.X)
+		case *ast.ArrayType:
+			walk(e.Elt)
+		case *ast.MapType:
+			walk(e.Key)
+			walk(e.Value)
+		case *ast.ParenExpr:
+			walk(e.X)
+		case *ast.StructType:
+			for _, field := range e.Fields.List {
+				walk(field.Type)
+			}
+		}
+	}
+	walk(expr)
+	return refs
+}
+
+// qualifyModelType rewrites references to shared types in a declaration as
+// references into the common package
+func qualifyModelType(spec *ast.TypeSpec, common map[string]bool) {
+	spec.Type = qualifyModelExpr(spec.Type, common)
+}
+
+func qualifyModelExpr(expr ast.Expr, common map[string]bool) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if common[e.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent(commonModelsPackage), Sel: ast.NewIdent(e.Name)}
+		}
+	case *ast.StarExpr:
+		e.X = qualifyModelExpr(e.X, common)
+	case *ast.ArrayType:
+		e.Elt = qualifyModelExpr(e.Elt, common)
+	case *ast.MapType:
+		e.Key = qualifyModelExpr(e.Key, common)
+		e.Value = qualifyModelExpr(e.Value, common)
+	case *ast.ParenExpr:
+		e.X = qualifyModelExpr(e.X, common)
+	case *ast.StructType:
+		for _, field := range e.Fields.List {
+			field.Type = qualifyModelExpr(field.Type, common)
+		}
+	}
+	return expr
+}
+
+// modelRoots lists the top-level elements of a document, XML name to Go
+// type: its elements that no other declaration in the document refers to
+func modelRoots(document *modelDocument) map[string]string {
+	referenced := make(map[string]bool)
+	for _, t := range document.Types {
+		for _, ref := range modelTypeRefs(t.Spec.Type) {
+			if ref != t.Name {
+				referenced[ref] = true
+			}
+		}
+	}
+
+	roots := make(map[string]string)
+	for _, t := range document.Types {
+		if !t.Element || referenced[t.Name] {
+			continue
+		}
+		if name := modelElementName(t.Spec); name != "" {
+			roots[name] = t.Name
+		}
+	}
+	return roots
+}
+
+// modelElementName reads the element name from the XMLName tag of a declaration
+func modelElementName(spec *ast.TypeSpec) string {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return ""
+	}
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 1 || field.Names[0].Name != "XMLName" || field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			return ""
+		}
+		name, _, _ := strings.Cut(reflect.StructTag(tag).Get("xml"), ",")
+		return name
+	}
+	return ""
+}
+
+// writeModelDocuments writes the Documents table of a version's models,
+// which makes a new model of each top-level element by document and element
+func writeModelDocuments(path, pkg, importPath string, documents []*modelDocument, roots map[string]map[string]string) error {
+	var imports, entries bytes.Buffer
+	packages := make(map[string]string)
+	for _, document := range documents {
+		elements := roots[document.Name]
+		if len(elements) == 0 {
+			continue
+		}
+		if other, ok := packages[document.Package]; ok {
+			return fmt.Errorf("documents %s and %s have the same package name %s", other, document.Name, document.Package)
+		}
+		packages[document.Package] = document.Name
+
+		if document.Package == document.Name {
+			fmt.Fprintf(&imports, "\t%q\n", importPath+"/"+document.Name)
+		} else {
+			fmt.Fprintf(&imports, "\t%s %q\n", document.Package, importPath+"/"+document.Name)
+		}
+
+		names := make([]string, 0, len(elements))
+		for name := range elements {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&entries, "\t%q: {\n", document.Name)
+		for _, name := range names {
+			fmt.Fprintf(&entries, "\t\t%q: func() any { return new(%s.%s) },\n", name, document.Package, elements[name])
+		}
+		entries.WriteString("\t},\n")
+	}
+
+	var src bytes.Buffer
+	src.WriteString(modelIndexHeader + "\n")
+	fmt.Fprintf(&src, "// Package %s lists the top-level elements of the documents in one schema version\n", pkg)
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+	if imports.Len() > 0 {
+		fmt.Fprintf(&src, "import (\n%s)\n\n", imports.String())
+	}
+	src.WriteString("// Documents makes a new model of each top-level element, by document and element name\n")
+	fmt.Fprintf(&src, "var Documents = map[string]map[string]func() any{\n%s}\n", entries.String())
+	return writeGoSource(path, src.Bytes())
+}
+
+// writeModelVersions writes the versions package, which lists every version
+// models were generated for, newest first
+func writeModelVersions(path string, generated []*SchemaVersion) error {
+	var imports, entries bytes.Buffer
+	for _, v := range generated {
+		dir := modelVersionDir(v)
+		fmt.Fprintf(&imports, "\t%s %q\n", modelPackageName(dir), modelsImportPath+"/"+dir)
+		fmt.Fprintf(&entries, "\t{Version: %q, Family: %q, Documents: %s.Documents},\n", v.Version, v.Family, modelPackageName(dir))
+	}
+
+	var src bytes.Buffer
+	src.WriteString(modelIndexHeader + "\n")
+	src.WriteString("// Package versions lists the schema versions models were generated for\n")
+	fmt.Fprintf(&src, "package %s\n\n", modelVersionsPackage)
+	fmt.Fprintf(&src, "import (\n%s)\n\n", imports.String())
+	src.WriteString(`// Version is the models generated from one schema version
+type Version struct {
+	Version string
+	Family  string
+	// Documents makes a new model of each top-level element, by document
+	// and element name
+	Documents map[string]map[string]func() any
+}
+
+`)
+	src.WriteString("// All lists the generated versions, newest first\n")
+	fmt.Fprintf(&src, "var All = []Version{\n%s}\n", entries.String())
+	return writeGoSource(path, src.Bytes())
+}
+
+// writeGoSource formats generated source and writes it to path
+func writeGoSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("failed to format %s: %w", path, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(path), err)
+	}
+	if err := os.WriteFile(path, formatted, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// writeModelPackage writes the declarations of one package to dir/<file>.go
+func writeModelPackage(fset *token.FileSet, dir, file, pkg, comment, importPath string, types []*modelType, common map[string]bool, validate *validateWriter) error {
+	var body bytes.Buffer
+	for _, t := range types {
+		if t.Doc != "" {
+			for _, line := range strings.Split(t.Doc, "\n") {
+				fmt.Fprintf(&body, "// %s\n", line)
+			}
+		}
+		fmt.Fprintf(&body, "type %s\n\n", printModelNode(fset, t.Spec))
+		validate.writeEnum(&body, t)
+	}
+
+	var src bytes.Buffer
+	src.WriteString(modelsHeader)
+	if comment != "" {
+		src.WriteString(comment + "\n")
+	}
+	fmt.Fprintf(&src, "package %s\n\n", pkg)
+
+	for _, t := range types {
+		validate.write(t)
+	}
+	var imports []string
+	if bytes.Contains(body.Bytes(), []byte("xml.")) || bytes.Contains(validate.code.Bytes(), []byte("xml.")) {
+		imports = append(imports, `"encoding/xml"`)
+	}
+	if len(common) > 0 && bytes.Contains(body.Bytes(), []byte(commonModelsPackage+".")) {
+		imports = append(imports, fmt.Sprintf("%q", importPath+"/"+commonModelsPackage))
+	}
+	if validate.usesXSD {
+		imports = append(imports, fmt.Sprintf("%q", xsdImportPath))
+	}
+	if len(imports) > 0 {
+		fmt.Fprintf(&src, "import (\n\t%s\n)\n\n", strings.Join(imports, "\n\t"))
+	}
+	src.Write(body.Bytes())
+	src.Write(validate.vars.Bytes())
+	src.Write(validate.code.Bytes())
+
+	return writeGoSource(filepath.Join(dir, file+".go"), src.Bytes())
+}
+
+// printModelNode prints a declaration without its comments
+func printModelNode(fset *token.FileSet, node ast.Node) string {
+	var buf bytes.Buffer
+	config := printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}
+	config.Fprint(&buf, fset, node)
+	return buf.String()
+}
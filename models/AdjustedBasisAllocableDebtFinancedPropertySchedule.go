@@ -1,1796 +0,0 @@
-// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
-// Models for http://www.irs.gov/efile
-package AdjustedBasisAllocableDebtFinancedPropertySchedule
-
-import (
-	"encoding/xml"
-)
-
-// Element
-type AdjBssAllcblDebtFincdPropSch struct {
-	XMLName xml.Name `xml:"AdjBssAllcblDebtFincdPropSch"`
-
-	DocumentName string `xml:"documentName,attr"`
-
-	DocumentId IdType `xml:"documentId,attr"`
-
-	SoftwareId SoftwareIdType `xml:"softwareId,attr"`
-
-	SoftwareVersionNum SoftwareVersionType `xml:"softwareVersionNum,attr"`
-
-	AdjBssAllcblDebtFincdPropGrp []AdjBssAllcblDebtFincdPropGrpType `xml:"AdjBssAllcblDebtFincdPropGrp"`
-
-	TotalAdjustedBasisPropertyAmt *UsamountType `xml:"TotalAdjustedBasisPropertyAmt"`
-}
-
-// XSD ComplexType declarations
-
-type UsitemizedEntryType struct {
-	XMLName xml.Name
-
-	Desc LineExplanationType `xml:"Desc"`
-
-	Amt UsamountType `xml:"Amt"`
-}
-
-type ForeignItemizedEntryType struct {
-	XMLName xml.Name
-
-	Desc LineExplanationType `xml:"Desc"`
-
-	ForeignAmt ForeignAmountType `xml:"ForeignAmt"`
-}
-
-type UsaddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm CityType `xml:"CityNm"`
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	Zipcd ZipcodeType `xml:"ZIPCd"`
-}
-
-type OtherUsaddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt *StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm *CityType `xml:"CityNm"`
-
-	StateAbbreviationCd *StateType `xml:"StateAbbreviationCd"`
-
-	Zipcd *ZipcodeType `xml:"ZIPCd"`
-}
-
-type ForeignAddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm string `xml:"CityNm"`
-
-	ProvinceOrStateNm string `xml:"ProvinceOrStateNm"`
-
-	CountryCd CountryType `xml:"CountryCd"`
-
-	ForeignPostalCd string `xml:"ForeignPostalCd"`
-}
-
-type OtherForeignAddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt *StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm string `xml:"CityNm"`
-
-	ProvinceOrStateNm string `xml:"ProvinceOrStateNm"`
-
-	CountryCd *CountryType `xml:"CountryCd"`
-
-	ForeignPostalCd string `xml:"ForeignPostalCd"`
-}
-
-type BusinessNameType struct {
-	XMLName xml.Name
-
-	BusinessNameLine1Txt BusinessNameLine1Type `xml:"BusinessNameLine1Txt"`
-
-	BusinessNameLine2Txt *BusinessNameLine2Type `xml:"BusinessNameLine2Txt"`
-}
-
-type NameAndAddressType struct {
-	XMLName xml.Name
-
-	PersonNm *PersonNameType `xml:"PersonNm"`
-
-	BusinessName *BusinessNameType `xml:"BusinessName"`
-
-	Usaddress *UsaddressType `xml:"USAddress"`
-
-	ForeignAddress *ForeignAddressType `xml:"ForeignAddress"`
-}
-
-type PersonFullNameType struct {
-	XMLName xml.Name
-
-	PersonFirstNm PersonFirstNameType `xml:"PersonFirstNm"`
-
-	PersonLastNm *PersonLastNameType `xml:"PersonLastNm"`
-}
-
-type IpaddressType struct {
-	XMLName xml.Name
-
-	Ipv4AddressTxt *Ipv4Type `xml:"IPv4AddressTxt"`
-
-	Ipv6AddressTxt *Ipv6Type `xml:"IPv6AddressTxt"`
-}
-
-type VehicleDescriptionGrpType struct {
-	XMLName xml.Name
-
-	VehicleModelYr YearType `xml:"VehicleModelYr"`
-
-	VehicleMakeNameTxt ShortDescriptionType `xml:"VehicleMakeNameTxt"`
-
-	VehicleModelNameTxt ShortDescriptionType `xml:"VehicleModelNameTxt"`
-}
-
-type ForeignEntityIdentificationGrpType struct {
-	XMLName xml.Name
-
-	ForeignEntityReferenceIdNum string `xml:",any"`
-}
-
-type Form1099StateLocalTaxType struct {
-	XMLName xml.Name
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	StateIdNum string `xml:"StateIdNum"`
-
-	StateTaxWithheldAmt UsamountNntype `xml:"StateTaxWithheldAmt"`
-
-	LocalAbbreviationCdTxt string `xml:"LocalAbbreviationCdTxt"`
-
-	LocalityNm *ShortDescriptionType `xml:"LocalityNm"`
-
-	LocalTaxWithheldAmt *UsamountNntype `xml:"LocalTaxWithheldAmt"`
-}
-
-type Form1099OtherStateLocalTaxType struct {
-	XMLName xml.Name
-
-	StateTaxWithheldAmt UsamountNntype `xml:"StateTaxWithheldAmt"`
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	StateIdNum string `xml:"StateIdNum"`
-
-	StateIncomeAmt *UsamountNntype `xml:"StateIncomeAmt"`
-
-	LocalTaxWithheldAmt *UsamountNntype `xml:"LocalTaxWithheldAmt"`
-
-	LocalityNm *ShortDescriptionType `xml:"LocalityNm"`
-
-	LocalIncomeAmt *UsamountNntype `xml:"LocalIncomeAmt"`
-}
-
-type AdjBssAllcblDebtFincdPropSchType struct {
-	XMLName xml.Name
-
-	AdjBssAllcblDebtFincdPropGrp []AdjBssAllcblDebtFincdPropGrpType `xml:"AdjBssAllcblDebtFincdPropGrp"`
-
-	TotalAdjustedBasisPropertyAmt *UsamountType `xml:"TotalAdjustedBasisPropertyAmt"`
-}
-
-type AdjBssAllcblDebtFincdPropGrpType struct {
-	XMLName xml.Name
-
-	PropertyLineNum string `xml:"PropertyLineNum"`
-
-	PropertyDesc *LineExplanationType `xml:"PropertyDesc"`
-
-	BeginningAdjustedBasisAmt *UsamountType `xml:"BeginningAdjustedBasisAmt"`
-
-	EndingAdjustedBasisAmt *UsamountType `xml:"EndingAdjustedBasisAmt"`
-
-	AverageAdjustedBasisAmt *UsamountType `xml:"AverageAdjustedBasisAmt"`
-
-	AllocableDebtFinancedIncomePct *RatioType `xml:"AllocableDebtFinancedIncomePct"`
-
-	AdjBssAllcblDebtFincdPropAmt *UsamountType `xml:"AdjBssAllcblDebtFincdPropAmt"`
-}
-
-// XSD SimpleType declarations
-
-type StringType string
-
-type Uritype string
-
-type DateType string
-
-type YearMonthType string
-
-type TaxYearEndMonthDtType string
-
-type MonthType string
-
-type MonthDayType string
-
-type QuarterEndDateType string
-
-type TimestampType string
-
-type YearType string
-
-type BooleanType bool
-
-type CheckboxType string
-
-const CheckboxTypeX CheckboxType = "X"
-
-type IntegerType int64
-
-type IntegerNntype uint64
-
-type IntegerPosType uint64
-
-type LongIntegerType int64
-
-type DecimalType float64
-
-type DecimalNntype float64
-
-type UsamountType int64
-
-type UsamountNntype uint64
-
-type UsamountNegType int64
-
-type UsamountNonPosType int64
-
-type UsamountPosType uint64
-
-type UsdecimalAmountType float64
-
-type UsdecimalAmountNntype float64
-
-type UsdecimalAmountPosType float64
-
-type UslargeDecimalAmountType float64
-
-type ForeignAmountType int64
-
-type ForeignAmountNntype uint64
-
-type Decimal2RatioType float64
-
-type Decimal4RatioType float64
-
-type SmallRatioType float64
-
-type RatioType float64
-
-type LargeRatioType float64
-
-type BusinessActivityCodeType int64
-
-type RoutingTransitNumberType string
-
-type BankAccountNumberType string
-
-type BankAccountType string
-
-const BankAccountType1 BankAccountType = "1"
-
-const BankAccountType2 BankAccountType = "2"
-
-type Ssntype string
-
-type Eintype string
-
-type Etintype string
-
-type Ptintype string
-
-type Efintype string
-
-type Pintype string
-
-type Isptype string
-
-type OriginatorType string
-
-const OriginatorTypeEro OriginatorType = "ERO"
-
-const OriginatorTypeOnlinefiler OriginatorType = "OnlineFiler"
-
-const OriginatorTypeReportingagent OriginatorType = "ReportingAgent"
-
-const OriginatorTypeFinancialagent OriginatorType = "FinancialAgent"
-
-const OriginatorTypeLargetaxpayer OriginatorType = "LargeTaxpayer"
-
-type SignatureType string
-
-type SoftwareIdType string
-
-type SoftwareVersionType string
-
-type IdType string
-
-type IdListType string
-
-type BusinessNameLine1Type string
-
-type BusinessNameLine2Type string
-
-type InCareOfNameType string
-
-type NameLine1Type string
-
-type PersonNameType string
-
-type PersonTitleType string
-
-type BusinessNameControlType string
-
-type CheckDigitType string
-
-type StreetAddressType string
-
-type CityType string
-
-type StateType string
-
-const StateTypeAl StateType = "AL"
-
-const StateTypeAk StateType = "AK"
-
-const StateTypeAs StateType = "AS"
-
-const StateTypeAz StateType = "AZ"
-
-const StateTypeAr StateType = "AR"
-
-const StateTypeCa StateType = "CA"
-
-const StateTypeCo StateType = "CO"
-
-const StateTypeMp StateType = "MP"
-
-const StateTypeCt StateType = "CT"
-
-const StateTypeDe StateType = "DE"
-
-const StateTypeDc StateType = "DC"
-
-const StateTypeFm StateType = "FM"
-
-const StateTypeFl StateType = "FL"
-
-const StateTypeGa StateType = "GA"
-
-const StateTypeGu StateType = "GU"
-
-const StateTypeHi StateType = "HI"
-
-const StateTypeId StateType = "ID"
-
-const StateTypeIl StateType = "IL"
-
-const StateTypeIn StateType = "IN"
-
-const StateTypeIa StateType = "IA"
-
-const StateTypeKs StateType = "KS"
-
-const StateTypeKy StateType = "KY"
-
-const StateTypeLa StateType = "LA"
-
-const StateTypeMe StateType = "ME"
-
-const StateTypeMh StateType = "MH"
-
-const StateTypeMd StateType = "MD"
-
-const StateTypeMa StateType = "MA"
-
-const StateTypeMi StateType = "MI"
-
-const StateTypeMn StateType = "MN"
-
-const StateTypeMs StateType = "MS"
-
-const StateTypeMo StateType = "MO"
-
-const StateTypeMt StateType = "MT"
-
-const StateTypeNe StateType = "NE"
-
-const StateTypeNv StateType = "NV"
-
-const StateTypeNh StateType = "NH"
-
-const StateTypeNj StateType = "NJ"
-
-const StateTypeNm StateType = "NM"
-
-const StateTypeNy StateType = "NY"
-
-const StateTypeNc StateType = "NC"
-
-const StateTypeNd StateType = "ND"
-
-const StateTypeOh StateType = "OH"
-
-const StateTypeOk StateType = "OK"
-
-const StateTypeOr StateType = "OR"
-
-const StateTypePw StateType = "PW"
-
-const StateTypePa StateType = "PA"
-
-const StateTypePr StateType = "PR"
-
-const StateTypeRi StateType = "RI"
-
-const StateTypeSc StateType = "SC"
-
-const StateTypeSd StateType = "SD"
-
-const StateTypeTn StateType = "TN"
-
-const StateTypeTx StateType = "TX"
-
-const StateTypeVi StateType = "VI"
-
-const StateTypeUt StateType = "UT"
-
-const StateTypeVt StateType = "VT"
-
-const StateTypeVa StateType = "VA"
-
-const StateTypeWa StateType = "WA"
-
-const StateTypeWv StateType = "WV"
-
-const StateTypeWi StateType = "WI"
-
-const StateTypeWy StateType = "WY"
-
-const StateTypeAa StateType = "AA"
-
-const StateTypeAe StateType = "AE"
-
-const StateTypeAp StateType = "AP"
-
-type FutastateCdType string
-
-const FutastateCdTypeAl FutastateCdType = "AL"
-
-const FutastateCdTypeAk FutastateCdType = "AK"
-
-const FutastateCdTypeAz FutastateCdType = "AZ"
-
-const FutastateCdTypeAr FutastateCdType = "AR"
-
-const FutastateCdTypeCa FutastateCdType = "CA"
-
-const FutastateCdTypeCo FutastateCdType = "CO"
-
-const FutastateCdTypeCt FutastateCdType = "CT"
-
-const FutastateCdTypeDe FutastateCdType = "DE"
-
-const FutastateCdTypeDc FutastateCdType = "DC"
-
-const FutastateCdTypeFl FutastateCdType = "FL"
-
-const FutastateCdTypeGa FutastateCdType = "GA"
-
-const FutastateCdTypeHi FutastateCdType = "HI"
-
-const FutastateCdTypeId FutastateCdType = "ID"
-
-const FutastateCdTypeIl FutastateCdType = "IL"
-
-const FutastateCdTypeIn FutastateCdType = "IN"
-
-const FutastateCdTypeIa FutastateCdType = "IA"
-
-const FutastateCdTypeKs FutastateCdType = "KS"
-
-const FutastateCdTypeKy FutastateCdType = "KY"
-
-const FutastateCdTypeLa FutastateCdType = "LA"
-
-const FutastateCdTypeMe FutastateCdType = "ME"
-
-const FutastateCdTypeMd FutastateCdType = "MD"
-
-const FutastateCdTypeMa FutastateCdType = "MA"
-
-const FutastateCdTypeMi FutastateCdType = "MI"
-
-const FutastateCdTypeMn FutastateCdType = "MN"
-
-const FutastateCdTypeMs FutastateCdType = "MS"
-
-const FutastateCdTypeMo FutastateCdType = "MO"
-
-const FutastateCdTypeMt FutastateCdType = "MT"
-
-const FutastateCdTypeNe FutastateCdType = "NE"
-
-const FutastateCdTypeNv FutastateCdType = "NV"
-
-const FutastateCdTypeNh FutastateCdType = "NH"
-
-const FutastateCdTypeNj FutastateCdType = "NJ"
-
-const FutastateCdTypeNm FutastateCdType = "NM"
-
-const FutastateCdTypeNy FutastateCdType = "NY"
-
-const FutastateCdTypeNc FutastateCdType = "NC"
-
-const FutastateCdTypeNd FutastateCdType = "ND"
-
-const FutastateCdTypeOh FutastateCdType = "OH"
-
-const FutastateCdTypeOk FutastateCdType = "OK"
-
-const FutastateCdTypeOr FutastateCdType = "OR"
-
-const FutastateCdTypePa FutastateCdType = "PA"
-
-const FutastateCdTypePr FutastateCdType = "PR"
-
-const FutastateCdTypeRi FutastateCdType = "RI"
-
-const FutastateCdTypeSc FutastateCdType = "SC"
-
-const FutastateCdTypeSd FutastateCdType = "SD"
-
-const FutastateCdTypeTn FutastateCdType = "TN"
-
-const FutastateCdTypeTx FutastateCdType = "TX"
-
-const FutastateCdTypeVi FutastateCdType = "VI"
-
-const FutastateCdTypeUt FutastateCdType = "UT"
-
-const FutastateCdTypeVt FutastateCdType = "VT"
-
-const FutastateCdTypeVa FutastateCdType = "VA"
-
-const FutastateCdTypeWa FutastateCdType = "WA"
-
-const FutastateCdTypeWv FutastateCdType = "WV"
-
-const FutastateCdTypeWi FutastateCdType = "WI"
-
-const FutastateCdTypeWy FutastateCdType = "WY"
-
-type ZipcodeType string
-
-type PhoneNumberType string
-
-type ForeignPhoneNumberType string
-
-type EmailAddressType string
-
-type TextType string
-
-type ExplanationType string
-
-type LineExplanationType string
-
-type ShortExplanationType string
-
-type ShortDescriptionType string
-
-type MediumExplanationType string
-
-type LongExplanationType string
-
-type CountryType string
-
-const CountryTypeAf CountryType = "AF"
-
-const CountryTypeAx CountryType = "AX"
-
-const CountryTypeAl CountryType = "AL"
-
-const CountryTypeAg CountryType = "AG"
-
-const CountryTypeAq CountryType = "AQ"
-
-const CountryTypeAn CountryType = "AN"
-
-const CountryTypeAo CountryType = "AO"
-
-const CountryTypeAv CountryType = "AV"
-
-const CountryTypeAy CountryType = "AY"
-
-const CountryTypeAc CountryType = "AC"
-
-const CountryTypeAr CountryType = "AR"
-
-const CountryTypeAm CountryType = "AM"
-
-const CountryTypeAa CountryType = "AA"
-
-const CountryTypeAt CountryType = "AT"
-
-const CountryTypeAs CountryType = "AS"
-
-const CountryTypeAu CountryType = "AU"
-
-const CountryTypeAj CountryType = "AJ"
-
-const CountryTypeBf CountryType = "BF"
-
-const CountryTypeBa CountryType = "BA"
-
-const CountryTypeFq CountryType = "FQ"
-
-const CountryTypeBg CountryType = "BG"
-
-const CountryTypeBb CountryType = "BB"
-
-const CountryTypeBo CountryType = "BO"
-
-const CountryTypeBe CountryType = "BE"
-
-const CountryTypeBh CountryType = "BH"
-
-const CountryTypeBn CountryType = "BN"
-
-const CountryTypeBd CountryType = "BD"
-
-const CountryTypeBt CountryType = "BT"
-
-const CountryTypeBl CountryType = "BL"
-
-const CountryTypeBk CountryType = "BK"
-
-const CountryTypeBc CountryType = "BC"
-
-const CountryTypeBv CountryType = "BV"
-
-const CountryTypeBr CountryType = "BR"
-
-const CountryTypeIo CountryType = "IO"
-
-const CountryTypeVi CountryType = "VI"
-
-const CountryTypeBx CountryType = "BX"
-
-const CountryTypeBu CountryType = "BU"
-
-const CountryTypeUv CountryType = "UV"
-
-const CountryTypeBm CountryType = "BM"
-
-const CountryTypeBy CountryType = "BY"
-
-const CountryTypeCb CountryType = "CB"
-
-const CountryTypeCm CountryType = "CM"
-
-const CountryTypeCa CountryType = "CA"
-
-const CountryTypeCv CountryType = "CV"
-
-const CountryTypeCj CountryType = "CJ"
-
-const CountryTypeCt CountryType = "CT"
-
-const CountryTypeCd CountryType = "CD"
-
-const CountryTypeCi CountryType = "CI"
-
-const CountryTypeCh CountryType = "CH"
-
-const CountryTypeKt CountryType = "KT"
-
-const CountryTypeIp CountryType = "IP"
-
-const CountryTypeCk CountryType = "CK"
-
-const CountryTypeCo CountryType = "CO"
-
-const CountryTypeCn CountryType = "CN"
-
-const CountryTypeCf CountryType = "CF"
-
-const CountryTypeCg CountryType = "CG"
-
-const CountryTypeCw CountryType = "CW"
-
-const CountryTypeCr CountryType = "CR"
-
-const CountryTypeCs CountryType = "CS"
-
-const CountryTypeIv CountryType = "IV"
-
-const CountryTypeHr CountryType = "HR"
-
-const CountryTypeCu CountryType = "CU"
-
-const CountryTypeUc CountryType = "UC"
-
-const CountryTypeCy CountryType = "CY"
-
-const CountryTypeEz CountryType = "EZ"
-
-const CountryTypeDa CountryType = "DA"
-
-const CountryTypeDx CountryType = "DX"
-
-const CountryTypeDj CountryType = "DJ"
-
-const CountryTypeDo CountryType = "DO"
-
-const CountryTypeDr CountryType = "DR"
-
-const CountryTypeTt CountryType = "TT"
-
-const CountryTypeEc CountryType = "EC"
-
-const CountryTypeEg CountryType = "EG"
-
-const CountryTypeEs CountryType = "ES"
-
-const CountryTypeEk CountryType = "EK"
-
-const CountryTypeEr CountryType = "ER"
-
-const CountryTypeEn CountryType = "EN"
-
-const CountryTypeEt CountryType = "ET"
-
-const CountryTypeFk CountryType = "FK"
-
-const CountryTypeFo CountryType = "FO"
-
-const CountryTypeFm CountryType = "FM"
-
-const CountryTypeFj CountryType = "FJ"
-
-const CountryTypeFi CountryType = "FI"
-
-const CountryTypeFr CountryType = "FR"
-
-const CountryTypeFp CountryType = "FP"
-
-const CountryTypeFs CountryType = "FS"
-
-const CountryTypeGb CountryType = "GB"
-
-const CountryTypeGa CountryType = "GA"
-
-const CountryTypeGg CountryType = "GG"
-
-const CountryTypeGm CountryType = "GM"
-
-const CountryTypeGh CountryType = "GH"
-
-const CountryTypeGi CountryType = "GI"
-
-const CountryTypeGr CountryType = "GR"
-
-const CountryTypeGl CountryType = "GL"
-
-const CountryTypeGj CountryType = "GJ"
-
-const CountryTypeGq CountryType = "GQ"
-
-const CountryTypeGt CountryType = "GT"
-
-const CountryTypeGk CountryType = "GK"
-
-const CountryTypeGv CountryType = "GV"
-
-const CountryTypePu CountryType = "PU"
-
-const CountryTypeGy CountryType = "GY"
-
-const CountryTypeHa CountryType = "HA"
-
-const CountryTypeHm CountryType = "HM"
-
-const CountryTypeVt CountryType = "VT"
-
-const CountryTypeHo CountryType = "HO"
-
-const CountryTypeHk CountryType = "HK"
-
-const CountryTypeHq CountryType = "HQ"
-
-const CountryTypeHu CountryType = "HU"
-
-const CountryTypeIc CountryType = "IC"
-
-const CountryTypeIn CountryType = "IN"
-
-const CountryTypeId CountryType = "ID"
-
-const CountryTypeIr CountryType = "IR"
-
-const CountryTypeIz CountryType = "IZ"
-
-const CountryTypeEi CountryType = "EI"
-
-const CountryTypeIs CountryType = "IS"
-
-const CountryTypeIt CountryType = "IT"
-
-const CountryTypeJm CountryType = "JM"
-
-const CountryTypeJn CountryType = "JN"
-
-const CountryTypeJa CountryType = "JA"
-
-const CountryTypeDq CountryType = "DQ"
-
-const CountryTypeJe CountryType = "JE"
-
-const CountryTypeJq CountryType = "JQ"
-
-const CountryTypeJo CountryType = "JO"
-
-const CountryTypeKz CountryType = "KZ"
-
-const CountryTypeKe CountryType = "KE"
-
-const CountryTypeKq CountryType = "KQ"
-
-const CountryTypeKr CountryType = "KR"
-
-const CountryTypeKn CountryType = "KN"
-
-const CountryTypeKs CountryType = "KS"
-
-const CountryTypeKv CountryType = "KV"
-
-const CountryTypeKu CountryType = "KU"
-
-const CountryTypeKg CountryType = "KG"
-
-const CountryTypeLa CountryType = "LA"
-
-const CountryTypeLg CountryType = "LG"
-
-const CountryTypeLe CountryType = "LE"
-
-const CountryTypeLt CountryType = "LT"
-
-const CountryTypeLi CountryType = "LI"
-
-const CountryTypeLy CountryType = "LY"
-
-const CountryTypeLs CountryType = "LS"
-
-const CountryTypeLh CountryType = "LH"
-
-const CountryTypeLu CountryType = "LU"
-
-const CountryTypeMc CountryType = "MC"
-
-const CountryTypeMk CountryType = "MK"
-
-const CountryTypeMa CountryType = "MA"
-
-const CountryTypeMi CountryType = "MI"
-
-const CountryTypeMy CountryType = "MY"
-
-const CountryTypeMv CountryType = "MV"
-
-const CountryTypeMl CountryType = "ML"
-
-const CountryTypeMt CountryType = "MT"
-
-const CountryTypeIm CountryType = "IM"
-
-const CountryTypeRm CountryType = "RM"
-
-const CountryTypeMr CountryType = "MR"
-
-const CountryTypeMp CountryType = "MP"
-
-const CountryTypeMx CountryType = "MX"
-
-const CountryTypeMq CountryType = "MQ"
-
-const CountryTypeMd CountryType = "MD"
-
-const CountryTypeMn CountryType = "MN"
-
-const CountryTypeMg CountryType = "MG"
-
-const CountryTypeMj CountryType = "MJ"
-
-const CountryTypeMh CountryType = "MH"
-
-const CountryTypeMo CountryType = "MO"
-
-const CountryTypeMz CountryType = "MZ"
-
-const CountryTypeWa CountryType = "WA"
-
-const CountryTypeNr CountryType = "NR"
-
-const CountryTypeBq CountryType = "BQ"
-
-const CountryTypeNp CountryType = "NP"
-
-const CountryTypeNl CountryType = "NL"
-
-const CountryTypeNc CountryType = "NC"
-
-const CountryTypeNz CountryType = "NZ"
-
-const CountryTypeNu CountryType = "NU"
-
-const CountryTypeNg CountryType = "NG"
-
-const CountryTypeNi CountryType = "NI"
-
-const CountryTypeNe CountryType = "NE"
-
-const CountryTypeNf CountryType = "NF"
-
-const CountryTypeCq CountryType = "CQ"
-
-const CountryTypeNo CountryType = "NO"
-
-const CountryTypeMu CountryType = "MU"
-
-const CountryTypeOc CountryType = "OC"
-
-const CountryTypePk CountryType = "PK"
-
-const CountryTypePs CountryType = "PS"
-
-const CountryTypeLq CountryType = "LQ"
-
-const CountryTypePm CountryType = "PM"
-
-const CountryTypePp CountryType = "PP"
-
-const CountryTypePf CountryType = "PF"
-
-const CountryTypePa CountryType = "PA"
-
-const CountryTypePe CountryType = "PE"
-
-const CountryTypeRp CountryType = "RP"
-
-const CountryTypePc CountryType = "PC"
-
-const CountryTypePl CountryType = "PL"
-
-const CountryTypePo CountryType = "PO"
-
-const CountryTypeRq CountryType = "RQ"
-
-const CountryTypeQa CountryType = "QA"
-
-const CountryTypeRo CountryType = "RO"
-
-const CountryTypeRs CountryType = "RS"
-
-const CountryTypeRw CountryType = "RW"
-
-const CountryTypeTb CountryType = "TB"
-
-const CountryTypeRn CountryType = "RN"
-
-const CountryTypeWs CountryType = "WS"
-
-const CountryTypeSm CountryType = "SM"
-
-const CountryTypeTp CountryType = "TP"
-
-const CountryTypeSa CountryType = "SA"
-
-const CountryTypeSg CountryType = "SG"
-
-const CountryTypeRi CountryType = "RI"
-
-const CountryTypeSe CountryType = "SE"
-
-const CountryTypeSl CountryType = "SL"
-
-const CountryTypeSn CountryType = "SN"
-
-const CountryTypeNn CountryType = "NN"
-
-const CountryTypeLo CountryType = "LO"
-
-const CountryTypeSi CountryType = "SI"
-
-const CountryTypeBp CountryType = "BP"
-
-const CountryTypeSo CountryType = "SO"
-
-const CountryTypeSf CountryType = "SF"
-
-const CountryTypeSx CountryType = "SX"
-
-const CountryTypeOd CountryType = "OD"
-
-const CountryTypeSp CountryType = "SP"
-
-const CountryTypePg CountryType = "PG"
-
-const CountryTypeCe CountryType = "CE"
-
-const CountryTypeSh CountryType = "SH"
-
-const CountryTypeSc CountryType = "SC"
-
-const CountryTypeSt CountryType = "ST"
-
-const CountryTypeSb CountryType = "SB"
-
-const CountryTypeVc CountryType = "VC"
-
-const CountryTypeSu CountryType = "SU"
-
-const CountryTypeNs CountryType = "NS"
-
-const CountryTypeSv CountryType = "SV"
-
-const CountryTypeWz CountryType = "WZ"
-
-const CountryTypeSw CountryType = "SW"
-
-const CountryTypeSz CountryType = "SZ"
-
-const CountryTypeSy CountryType = "SY"
-
-const CountryTypeTw CountryType = "TW"
-
-const CountryTypeTi CountryType = "TI"
-
-const CountryTypeTz CountryType = "TZ"
-
-const CountryTypeTh CountryType = "TH"
-
-const CountryTypeTo CountryType = "TO"
-
-const CountryTypeTl CountryType = "TL"
-
-const CountryTypeTn CountryType = "TN"
-
-const CountryTypeTd CountryType = "TD"
-
-const CountryTypeTs CountryType = "TS"
-
-const CountryTypeTu CountryType = "TU"
-
-const CountryTypeTx CountryType = "TX"
-
-const CountryTypeTk CountryType = "TK"
-
-const CountryTypeTv CountryType = "TV"
-
-const CountryTypeUg CountryType = "UG"
-
-const CountryTypeUp CountryType = "UP"
-
-const CountryTypeAe CountryType = "AE"
-
-const CountryTypeUk CountryType = "UK"
-
-const CountryTypeUy CountryType = "UY"
-
-const CountryTypeUz CountryType = "UZ"
-
-const CountryTypeNh CountryType = "NH"
-
-const CountryTypeVe CountryType = "VE"
-
-const CountryTypeVm CountryType = "VM"
-
-const CountryTypeVq CountryType = "VQ"
-
-const CountryTypeWq CountryType = "WQ"
-
-const CountryTypeWf CountryType = "WF"
-
-const CountryTypeWi CountryType = "WI"
-
-const CountryTypeYm CountryType = "YM"
-
-const CountryTypeZa CountryType = "ZA"
-
-const CountryTypeZi CountryType = "ZI"
-
-type AllCountriesType string
-
-type DepreciationConventionCodeType string
-
-const DepreciationConventionCodeTypeHy DepreciationConventionCodeType = "HY"
-
-const DepreciationConventionCodeTypeMq DepreciationConventionCodeType = "MQ"
-
-const DepreciationConventionCodeTypeMm DepreciationConventionCodeType = "MM"
-
-const DepreciationConventionCodeTypeSl DepreciationConventionCodeType = "S/L"
-
-type DepreciationConventionType string
-
-type DepreciationMethodCodeType string
-
-const DepreciationMethodCodeType200Db DepreciationMethodCodeType = "200 DB"
-
-const DepreciationMethodCodeType150Db DepreciationMethodCodeType = "150 DB"
-
-const DepreciationMethodCodeTypeDb DepreciationMethodCodeType = "DB"
-
-const DepreciationMethodCodeTypeSl DepreciationMethodCodeType = "S/L"
-
-const DepreciationMethodCodeTypeVarious DepreciationMethodCodeType = "Various"
-
-type DepreciationMethodType string
-
-type Bintype string
-
-type GtxkeyType string
-
-type MethodOfAccountingType string
-
-const MethodOfAccountingTypeCash MethodOfAccountingType = "cash"
-
-const MethodOfAccountingTypeAccrual MethodOfAccountingType = "accrual"
-
-const MethodOfAccountingTypeHybrid MethodOfAccountingType = "hybrid"
-
-type NumericType string
-
-type AlphaNumericType string
-
-type AlphaNumericAndParenthesesType string
-
-type IrsserviceCenterType string
-
-type StringVarioustype string
-
-const StringVarioustypeVarious StringVarioustype = "VARIOUS"
-
-type CusipnumberType string
-
-type FuelGallonsType int64
-
-type FuelGallonsNntype uint64
-
-type MilesType int64
-
-type MilesNntype uint64
-
-type GovernmentCodeType string
-
-const GovernmentCodeTypeIrs GovernmentCodeType = "IRS"
-
-const GovernmentCodeTypeAlst GovernmentCodeType = "ALST"
-
-const GovernmentCodeTypeAkst GovernmentCodeType = "AKST"
-
-const GovernmentCodeTypeAzst GovernmentCodeType = "AZST"
-
-const GovernmentCodeTypeArst GovernmentCodeType = "ARST"
-
-const GovernmentCodeTypeCast GovernmentCodeType = "CAST"
-
-const GovernmentCodeTypeCnct GovernmentCodeType = "CNCT"
-
-const GovernmentCodeTypeCoct GovernmentCodeType = "COCT"
-
-const GovernmentCodeTypeCost GovernmentCodeType = "COST"
-
-const GovernmentCodeTypeCtst GovernmentCodeType = "CTST"
-
-const GovernmentCodeTypeDect GovernmentCodeType = "DECT"
-
-const GovernmentCodeTypeDest GovernmentCodeType = "DEST"
-
-const GovernmentCodeTypeDcst GovernmentCodeType = "DCST"
-
-const GovernmentCodeTypeFlst GovernmentCodeType = "FLST"
-
-const GovernmentCodeTypeGast GovernmentCodeType = "GAST"
-
-const GovernmentCodeTypeHist GovernmentCodeType = "HIST"
-
-const GovernmentCodeTypeIdst GovernmentCodeType = "IDST"
-
-const GovernmentCodeTypeIlst GovernmentCodeType = "ILST"
-
-const GovernmentCodeTypeInst GovernmentCodeType = "INST"
-
-const GovernmentCodeTypeIast GovernmentCodeType = "IAST"
-
-const GovernmentCodeTypeKact GovernmentCodeType = "KACT"
-
-const GovernmentCodeTypeKsst GovernmentCodeType = "KSST"
-
-const GovernmentCodeTypeKyst GovernmentCodeType = "KYST"
-
-const GovernmentCodeTypeLast GovernmentCodeType = "LAST"
-
-const GovernmentCodeTypeLect GovernmentCodeType = "LECT"
-
-const GovernmentCodeTypeLxct GovernmentCodeType = "LXCT"
-
-const GovernmentCodeTypeMest GovernmentCodeType = "MEST"
-
-const GovernmentCodeTypeMdst GovernmentCodeType = "MDST"
-
-const GovernmentCodeTypeMast GovernmentCodeType = "MAST"
-
-const GovernmentCodeTypeMist GovernmentCodeType = "MIST"
-
-const GovernmentCodeTypeMnst GovernmentCodeType = "MNST"
-
-const GovernmentCodeTypeMsst GovernmentCodeType = "MSST"
-
-const GovernmentCodeTypeMost GovernmentCodeType = "MOST"
-
-const GovernmentCodeTypeMtst GovernmentCodeType = "MTST"
-
-const GovernmentCodeTypeNest GovernmentCodeType = "NEST"
-
-const GovernmentCodeTypeNvst GovernmentCodeType = "NVST"
-
-const GovernmentCodeTypeNhst GovernmentCodeType = "NHST"
-
-const GovernmentCodeTypeNjst GovernmentCodeType = "NJST"
-
-const GovernmentCodeTypeNmst GovernmentCodeType = "NMST"
-
-const GovernmentCodeTypeNyst GovernmentCodeType = "NYST"
-
-const GovernmentCodeTypeNcst GovernmentCodeType = "NCST"
-
-const GovernmentCodeTypeNdst GovernmentCodeType = "NDST"
-
-const GovernmentCodeTypeOcct GovernmentCodeType = "OCCT"
-
-const GovernmentCodeTypeOhst GovernmentCodeType = "OHST"
-
-const GovernmentCodeTypeOkst GovernmentCodeType = "OKST"
-
-const GovernmentCodeTypeOrct GovernmentCodeType = "ORCT"
-
-const GovernmentCodeTypeOrst GovernmentCodeType = "ORST"
-
-const GovernmentCodeTypePast GovernmentCodeType = "PAST"
-
-const GovernmentCodeTypePoct GovernmentCodeType = "POCT"
-
-const GovernmentCodeTypeRist GovernmentCodeType = "RIST"
-
-const GovernmentCodeTypeScst GovernmentCodeType = "SCST"
-
-const GovernmentCodeTypeSdst GovernmentCodeType = "SDST"
-
-const GovernmentCodeTypeSlct GovernmentCodeType = "SLCT"
-
-const GovernmentCodeTypeTnst GovernmentCodeType = "TNST"
-
-const GovernmentCodeTypeToct GovernmentCodeType = "TOCT"
-
-const GovernmentCodeTypeTxst GovernmentCodeType = "TXST"
-
-const GovernmentCodeTypeUtst GovernmentCodeType = "UTST"
-
-const GovernmentCodeTypeVtst GovernmentCodeType = "VTST"
-
-const GovernmentCodeTypeVast GovernmentCodeType = "VAST"
-
-const GovernmentCodeTypeWast GovernmentCodeType = "WAST"
-
-const GovernmentCodeTypeWvst GovernmentCodeType = "WVST"
-
-const GovernmentCodeTypeWist GovernmentCodeType = "WIST"
-
-const GovernmentCodeTypeWyst GovernmentCodeType = "WYST"
-
-const GovernmentCodeTypeNyct GovernmentCodeType = "NYCT"
-
-const GovernmentCodeTypePhct GovernmentCodeType = "PHCT"
-
-type TaxShelterRegistrationType string
-
-type NumberOfSharesType int64
-
-type Vintype string
-
-type NumberOfDaysType int64
-
-type PinenteredByType string
-
-const PinenteredByTypeTaxpayer PinenteredByType = "Taxpayer"
-
-const PinenteredByTypeEro PinenteredByType = "ERO"
-
-type PincodeType string
-
-const PincodeTypePractitioner PincodeType = "Practitioner"
-
-const PincodeTypeSelfSelectPractitioner PincodeType = "Self-Select Practitioner"
-
-const PincodeTypeSelfSelectOnLine PincodeType = "Self-Select On-Line"
-
-type ConsortiumType string
-
-const ConsortiumTypeEnglishFreeFile ConsortiumType = "English Free-File"
-
-const ConsortiumTypeSpanishFreeFile ConsortiumType = "Spanish Free-File"
-
-const ConsortiumTypeFreeFillableForms ConsortiumType = "Free Fillable Forms"
-
-type PartnersPageFilingType string
-
-const PartnersPageFilingTypePartnersPageEnglish PartnersPageFilingType = "Partners Page English"
-
-const PartnersPageFilingTypePartnersPageSpanish PartnersPageFilingType = "Partners Page Spanish"
-
-type PersonFirstNameType string
-
-type PersonLastNameType string
-
-type PersonNameControlType string
-
-type Ipv4Type string
-
-type Ipv6Type string
-
-type TimeType string
-
-type TimezoneType string
-
-const TimezoneTypeUs TimezoneType = "US"
-
-const TimezoneTypeEs TimezoneType = "ES"
-
-const TimezoneTypeEd TimezoneType = "ED"
-
-const TimezoneTypeCs TimezoneType = "CS"
-
-const TimezoneTypeCd TimezoneType = "CD"
-
-const TimezoneTypeMs TimezoneType = "MS"
-
-const TimezoneTypeMd TimezoneType = "MD"
-
-const TimezoneTypePs TimezoneType = "PS"
-
-const TimezoneTypePd TimezoneType = "PD"
-
-const TimezoneTypeAs TimezoneType = "AS"
-
-const TimezoneTypeAd TimezoneType = "AD"
-
-const TimezoneTypeHs TimezoneType = "HS"
-
-const TimezoneTypeHd TimezoneType = "HD"
-
-type Stintype string
-
-type RegistrationNumType string
-
-type DeviceIdType string
-
-type ForeignRegulatedInvestmtCompCdType string
-
-const ForeignRegulatedInvestmtCompCdTypeRic ForeignRegulatedInvestmtCompCdType = "RIC"
-
-type SubmissionIdType string
-
-type Ftintype string
-
-type CurrencyCodeType string
-
-const CurrencyCodeTypeXua CurrencyCodeType = "XUA"
-
-const CurrencyCodeTypeAfn CurrencyCodeType = "AFN"
-
-const CurrencyCodeTypeDzd CurrencyCodeType = "DZD"
-
-const CurrencyCodeTypeArs CurrencyCodeType = "ARS"
-
-const CurrencyCodeTypeAmd CurrencyCodeType = "AMD"
-
-const CurrencyCodeTypeAwg CurrencyCodeType = "AWG"
-
-const CurrencyCodeTypeAud CurrencyCodeType = "AUD"
-
-const CurrencyCodeTypeAzn CurrencyCodeType = "AZN"
-
-const CurrencyCodeTypeBsd CurrencyCodeType = "BSD"
-
-const CurrencyCodeTypeBhd CurrencyCodeType = "BHD"
-
-const CurrencyCodeTypeThb CurrencyCodeType = "THB"
-
-const CurrencyCodeTypePab CurrencyCodeType = "PAB"
-
-const CurrencyCodeTypeBbd CurrencyCodeType = "BBD"
-
-const CurrencyCodeTypeByn CurrencyCodeType = "BYN"
-
-const CurrencyCodeTypeBzd CurrencyCodeType = "BZD"
-
-const CurrencyCodeTypeBmd CurrencyCodeType = "BMD"
-
-const CurrencyCodeTypeVes CurrencyCodeType = "VES"
-
-const CurrencyCodeTypeBob CurrencyCodeType = "BOB"
-
-const CurrencyCodeTypeBrl CurrencyCodeType = "BRL"
-
-const CurrencyCodeTypeBnd CurrencyCodeType = "BND"
-
-const CurrencyCodeTypeBgn CurrencyCodeType = "BGN"
-
-const CurrencyCodeTypeBif CurrencyCodeType = "BIF"
-
-const CurrencyCodeTypeCve CurrencyCodeType = "CVE"
-
-const CurrencyCodeTypeCad CurrencyCodeType = "CAD"
-
-const CurrencyCodeTypeKyd CurrencyCodeType = "KYD"
-
-const CurrencyCodeTypeXof CurrencyCodeType = "XOF"
-
-const CurrencyCodeTypeXaf CurrencyCodeType = "XAF"
-
-const CurrencyCodeTypeXpf CurrencyCodeType = "XPF"
-
-const CurrencyCodeTypeClp CurrencyCodeType = "CLP"
-
-const CurrencyCodeTypeCop CurrencyCodeType = "COP"
-
-const CurrencyCodeTypeKmf CurrencyCodeType = "KMF"
-
-const CurrencyCodeTypeCdf CurrencyCodeType = "CDF"
-
-const CurrencyCodeTypeBam CurrencyCodeType = "BAM"
-
-const CurrencyCodeTypeNio CurrencyCodeType = "NIO"
-
-const CurrencyCodeTypeCrc CurrencyCodeType = "CRC"
-
-const CurrencyCodeTypeCup CurrencyCodeType = "CUP"
-
-const CurrencyCodeTypeCzk CurrencyCodeType = "CZK"
-
-const CurrencyCodeTypeGmd CurrencyCodeType = "GMD"
-
-const CurrencyCodeTypeDkk CurrencyCodeType = "DKK"
-
-const CurrencyCodeTypeMkd CurrencyCodeType = "MKD"
-
-const CurrencyCodeTypeDjf CurrencyCodeType = "DJF"
-
-const CurrencyCodeTypeStn CurrencyCodeType = "STN"
-
-const CurrencyCodeTypeDop CurrencyCodeType = "DOP"
-
-const CurrencyCodeTypeVnd CurrencyCodeType = "VND"
-
-const CurrencyCodeTypeXcd CurrencyCodeType = "XCD"
-
-const CurrencyCodeTypeEgp CurrencyCodeType = "EGP"
-
-const CurrencyCodeTypeSvc CurrencyCodeType = "SVC"
-
-const CurrencyCodeTypeEtb CurrencyCodeType = "ETB"
-
-const CurrencyCodeTypeEur CurrencyCodeType = "EUR"
-
-const CurrencyCodeTypeFkp CurrencyCodeType = "FKP"
-
-const CurrencyCodeTypeFjd CurrencyCodeType = "FJD"
-
-const CurrencyCodeTypeHuf CurrencyCodeType = "HUF"
-
-const CurrencyCodeTypeGhs CurrencyCodeType = "GHS"
-
-const CurrencyCodeTypeGip CurrencyCodeType = "GIP"
-
-const CurrencyCodeTypeHtg CurrencyCodeType = "HTG"
-
-const CurrencyCodeTypePyg CurrencyCodeType = "PYG"
-
-const CurrencyCodeTypeGnf CurrencyCodeType = "GNF"
-
-const CurrencyCodeTypeGyd CurrencyCodeType = "GYD"
-
-const CurrencyCodeTypeHkd CurrencyCodeType = "HKD"
-
-const CurrencyCodeTypeUah CurrencyCodeType = "UAH"
-
-const CurrencyCodeTypeIsk CurrencyCodeType = "ISK"
-
-const CurrencyCodeTypeInr CurrencyCodeType = "INR"
-
-const CurrencyCodeTypeIrr CurrencyCodeType = "IRR"
-
-const CurrencyCodeTypeIqd CurrencyCodeType = "IQD"
-
-const CurrencyCodeTypeJmd CurrencyCodeType = "JMD"
-
-const CurrencyCodeTypeJod CurrencyCodeType = "JOD"
-
-const CurrencyCodeTypeKes CurrencyCodeType = "KES"
-
-const CurrencyCodeTypePgk CurrencyCodeType = "PGK"
-
-const CurrencyCodeTypeHrk CurrencyCodeType = "HRK"
-
-const CurrencyCodeTypeKwd CurrencyCodeType = "KWD"
-
-const CurrencyCodeTypeAoa CurrencyCodeType = "AOA"
-
-const CurrencyCodeTypeMmk CurrencyCodeType = "MMK"
-
-const CurrencyCodeTypeLak CurrencyCodeType = "LAK"
-
-const CurrencyCodeTypeGel CurrencyCodeType = "GEL"
-
-const CurrencyCodeTypeLbp CurrencyCodeType = "LBP"
-
-const CurrencyCodeTypeAll CurrencyCodeType = "ALL"
-
-const CurrencyCodeTypeHnl CurrencyCodeType = "HNL"
-
-const CurrencyCodeTypeSll CurrencyCodeType = "SLL"
-
-const CurrencyCodeTypeLrd CurrencyCodeType = "LRD"
-
-const CurrencyCodeTypeLyd CurrencyCodeType = "LYD"
-
-const CurrencyCodeTypeSzl CurrencyCodeType = "SZL"
-
-const CurrencyCodeTypeLsl CurrencyCodeType = "LSL"
-
-const CurrencyCodeTypeMga CurrencyCodeType = "MGA"
-
-const CurrencyCodeTypeMwk CurrencyCodeType = "MWK"
-
-const CurrencyCodeTypeMyr CurrencyCodeType = "MYR"
-
-const CurrencyCodeTypeMur CurrencyCodeType = "MUR"
-
-const CurrencyCodeTypeMxn CurrencyCodeType = "MXN"
-
-const CurrencyCodeTypeMxv CurrencyCodeType = "MXV"
-
-const CurrencyCodeTypeMdl CurrencyCodeType = "MDL"
-
-const CurrencyCodeTypeMad CurrencyCodeType = "MAD"
-
-const CurrencyCodeTypeMzn CurrencyCodeType = "MZN"
-
-const CurrencyCodeTypeBov CurrencyCodeType = "BOV"
-
-const CurrencyCodeTypeNgn CurrencyCodeType = "NGN"
-
-const CurrencyCodeTypeErn CurrencyCodeType = "ERN"
-
-const CurrencyCodeTypeNad CurrencyCodeType = "NAD"
-
-const CurrencyCodeTypeNpr CurrencyCodeType = "NPR"
-
-const CurrencyCodeTypeAng CurrencyCodeType = "ANG"
-
-const CurrencyCodeTypeIls CurrencyCodeType = "ILS"
-
-const CurrencyCodeTypeTwd CurrencyCodeType = "TWD"
-
-const CurrencyCodeTypeNzd CurrencyCodeType = "NZD"
-
-const CurrencyCodeTypeBtn CurrencyCodeType = "BTN"
-
-const CurrencyCodeTypeKpw CurrencyCodeType = "KPW"
-
-const CurrencyCodeTypeNok CurrencyCodeType = "NOK"
-
-const CurrencyCodeTypeMru CurrencyCodeType = "MRU"
-
-const CurrencyCodeTypeTop CurrencyCodeType = "TOP"
-
-const CurrencyCodeTypePkr CurrencyCodeType = "PKR"
-
-const CurrencyCodeTypeMop CurrencyCodeType = "MOP"
-
-const CurrencyCodeTypeCuc CurrencyCodeType = "CUC"
-
-const CurrencyCodeTypeUyu CurrencyCodeType = "UYU"
-
-const CurrencyCodeTypePhp CurrencyCodeType = "PHP"
-
-const CurrencyCodeTypeGbp CurrencyCodeType = "GBP"
-
-const CurrencyCodeTypeBwp CurrencyCodeType = "BWP"
-
-const CurrencyCodeTypeQar CurrencyCodeType = "QAR"
-
-const CurrencyCodeTypeGtq CurrencyCodeType = "GTQ"
-
-const CurrencyCodeTypeZar CurrencyCodeType = "ZAR"
-
-const CurrencyCodeTypeOmr CurrencyCodeType = "OMR"
-
-const CurrencyCodeTypeKhr CurrencyCodeType = "KHR"
-
-const CurrencyCodeTypeRon CurrencyCodeType = "RON"
-
-const CurrencyCodeTypeMvr CurrencyCodeType = "MVR"
-
-const CurrencyCodeTypeIdr CurrencyCodeType = "IDR"
-
-const CurrencyCodeTypeRub CurrencyCodeType = "RUB"
-
-const CurrencyCodeTypeRwf CurrencyCodeType = "RWF"
-
-const CurrencyCodeTypeShp CurrencyCodeType = "SHP"
-
-const CurrencyCodeTypeSar CurrencyCodeType = "SAR"
-
-const CurrencyCodeTypeXdr CurrencyCodeType = "XDR"
-
-const CurrencyCodeTypeRsd CurrencyCodeType = "RSD"
-
-const CurrencyCodeTypeScr CurrencyCodeType = "SCR"
-
-const CurrencyCodeTypeSgd CurrencyCodeType = "SGD"
-
-const CurrencyCodeTypePen CurrencyCodeType = "PEN"
-
-const CurrencyCodeTypeSbd CurrencyCodeType = "SBD"
-
-const CurrencyCodeTypeKgs CurrencyCodeType = "KGS"
-
-const CurrencyCodeTypeSos CurrencyCodeType = "SOS"
-
-const CurrencyCodeTypeTjs CurrencyCodeType = "TJS"
-
-const CurrencyCodeTypeSsp CurrencyCodeType = "SSP"
-
-const CurrencyCodeTypeLkr CurrencyCodeType = "LKR"
-
-const CurrencyCodeTypeXsu CurrencyCodeType = "XSU"
-
-const CurrencyCodeTypeSdg CurrencyCodeType = "SDG"
-
-const CurrencyCodeTypeSrd CurrencyCodeType = "SRD"
-
-const CurrencyCodeTypeSek CurrencyCodeType = "SEK"
-
-const CurrencyCodeTypeChf CurrencyCodeType = "CHF"
-
-const CurrencyCodeTypeSyp CurrencyCodeType = "SYP"
-
-const CurrencyCodeTypeBdt CurrencyCodeType = "BDT"
-
-const CurrencyCodeTypeWst CurrencyCodeType = "WST"
-
-const CurrencyCodeTypeTzs CurrencyCodeType = "TZS"
-
-const CurrencyCodeTypeKzt CurrencyCodeType = "KZT"
-
-const CurrencyCodeTypeTtd CurrencyCodeType = "TTD"
-
-const CurrencyCodeTypeMnt CurrencyCodeType = "MNT"
-
-const CurrencyCodeTypeTnd CurrencyCodeType = "TND"
-
-const CurrencyCodeTypeTry CurrencyCodeType = "TRY"
-
-const CurrencyCodeTypeTmt CurrencyCodeType = "TMT"
-
-const CurrencyCodeTypeAed CurrencyCodeType = "AED"
-
-const CurrencyCodeTypeUgx CurrencyCodeType = "UGX"
-
-const CurrencyCodeTypeClf CurrencyCodeType = "CLF"
-
-const CurrencyCodeTypeCou CurrencyCodeType = "COU"
-
-const CurrencyCodeTypeUyw CurrencyCodeType = "UYW"
-
-const CurrencyCodeTypeUyi CurrencyCodeType = "UYI"
-
-const CurrencyCodeTypeUsd CurrencyCodeType = "USD"
-
-const CurrencyCodeTypeUsn CurrencyCodeType = "USN"
-
-const CurrencyCodeTypeUzs CurrencyCodeType = "UZS"
-
-const CurrencyCodeTypeVuv CurrencyCodeType = "VUV"
-
-const CurrencyCodeTypeChe CurrencyCodeType = "CHE"
-
-const CurrencyCodeTypeChw CurrencyCodeType = "CHW"
-
-const CurrencyCodeTypeKrw CurrencyCodeType = "KRW"
-
-const CurrencyCodeTypeYer CurrencyCodeType = "YER"
-
-const CurrencyCodeTypeJpy CurrencyCodeType = "JPY"
-
-const CurrencyCodeTypeCny CurrencyCodeType = "CNY"
-
-const CurrencyCodeTypeZmw CurrencyCodeType = "ZMW"
-
-const CurrencyCodeTypeZwl CurrencyCodeType = "ZWL"
-
-const CurrencyCodeTypePln CurrencyCodeType = "PLN"
-
-type PtepgroupCodeType string
-
-const PtepgroupCodeTypeR965A PtepgroupCodeType = "R965a"
-
-const PtepgroupCodeTypeR965B PtepgroupCodeType = "R965b"
-
-const PtepgroupCodeType959C1 PtepgroupCodeType = "959c1"
-
-const PtepgroupCodeTypeR951A PtepgroupCodeType = "R951A"
-
-const PtepgroupCodeTypeR245Ad PtepgroupCodeType = "R245Ad"
-
-const PtepgroupCodeType965A PtepgroupCodeType = "965a"
-
-const PtepgroupCodeType965B PtepgroupCodeType = "965b"
-
-const PtepgroupCodeType951A PtepgroupCodeType = "951A"
-
-const PtepgroupCodeType245Ad PtepgroupCodeType = "245Ad"
-
-const PtepgroupCodeType951A1A PtepgroupCodeType = "951a1A"
-
-type StockDescriptionType string
-
-type Decimal1RatioType float64
-
-type Decimal3RatioType float64
-
-type AlphaRowIdType string
-
-type RomanRowIdType string
-
-type NumericRowIdType uint64
-
-type AartrackingNumberType string
-
-type FacilityIrsissdRegistrationNumType string
-
-type LatitudeCoordinateType float64
-
-type LongitudeCoordinateType float64
-
-type NpsprojectNumType string
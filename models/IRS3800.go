@@ -1,13024 +0,0 @@
-// Code generated by https://github.com/gocomply/xsd2go; DO NOT EDIT.
-// Models for http://www.irs.gov/efile
-package IRS3800
-
-import (
-	"encoding/xml"
-)
-
-// Element
-type Irs3800 struct {
-	XMLName xml.Name `xml:"IRS3800"`
-
-	DocumentName string `xml:"documentName,attr"`
-
-	ReferenceDocumentId string `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName string `xml:"referenceDocumentName,attr"`
-
-	DocumentId IdType `xml:"documentId,attr"`
-
-	SoftwareId SoftwareIdType `xml:"softwareId,attr"`
-
-	SoftwareVersionNum SoftwareVersionType `xml:"softwareVersionNum,attr"`
-
-	CamtandBeatind *BooleanType `xml:"CAMTAndBEATInd"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PssvActyForGenBusCrAllowedAmt *UsamountNntype `xml:"PssvActyForGenBusCrAllowedAmt"`
-
-	CygeneralBusCrCarryforwardAmt *Irs3800CygeneralBusCrCarryforwardAmt `xml:"CYGeneralBusCrCarryforwardAmt"`
-
-	CarryBackGeneralBusinessCrAmt *UsamountType `xml:"CarryBackGeneralBusinessCrAmt"`
-
-	CycreditsNotAllwAgainstTmtamt *UsamountNntype `xml:"CYCreditsNotAllwAgainstTMTAmt"`
-
-	RegularTaxBeforeCreditsAmt *UsamountType `xml:"RegularTaxBeforeCreditsAmt"`
-
-	AlternativeMinimumTaxAmt *UsamountType `xml:"AlternativeMinimumTaxAmt"`
-
-	AdjustedRegTaxBeforeCreditAmt *UsamountType `xml:"AdjustedRegTaxBeforeCreditAmt"`
-
-	ForeignTaxCreditAmt *UsamountType `xml:"ForeignTaxCreditAmt"`
-
-	CertainAllowableCreditsAmt *UsamountType `xml:"CertainAllowableCreditsAmt"`
-
-	TotalTaxCreditsAmt *UsamountType `xml:"TotalTaxCreditsAmt"`
-
-	NetIncomeTaxAmt *UsamountNntype `xml:"NetIncomeTaxAmt"`
-
-	NetRegularTaxAmt *UsamountNntype `xml:"NetRegularTaxAmt"`
-
-	ExcessNetRegularTaxAmt *UsamountType `xml:"ExcessNetRegularTaxAmt"`
-
-	TentativeMinimumTaxAmt *UsamountType `xml:"TentativeMinimumTaxAmt"`
-
-	AdjustedExcessNetRegularTaxAmt *UsamountType `xml:"AdjustedExcessNetRegularTaxAmt"`
-
-	AdjustedNetIncomeTaxAmt *UsamountNntype `xml:"AdjustedNetIncomeTaxAmt"`
-
-	SmllrCynotAllwTmtorTotAdjAmt *Irs3800SmllrCynotAllwTmtorTotAdjAmt `xml:"SmllrCYNotAllwTMTOrTotAdjAmt"`
-
-	TentativeMinimunTaxTimesPctAmt *UsamountType `xml:"TentativeMinimunTaxTimesPctAmt"`
-
-	GreaterExcessOrTimesPctAmt *UsamountType `xml:"GreaterExcessOrTimesPctAmt"`
-
-	NetIncmTaxLessGreaterExcessAmt *UsamountNntype `xml:"NetIncmTaxLessGreaterExcessAmt"`
-
-	SubSmllrFromNetLessGreaterAmt *UsamountNntype `xml:"SubSmllrFromNetLessGreaterAmt"`
-
-	TotEmpwrZoneGenBusCreditsAmt *UsamountNntype `xml:"TotEmpwrZoneGenBusCreditsAmt"`
-
-	GbcfromPssvActyAllPartsAmt *UsamountNntype `xml:"GBCFromPssvActyAllPartsAmt"`
-
-	PassiveActyAllowedForTyamt *UsamountNntype `xml:"PassiveActyAllowedForTYAmt"`
-
-	TotalPassiveActivityCreditAmt *UsamountType `xml:"TotalPassiveActivityCreditAmt"`
-
-	EmpwrZoneAndComEmploymentCrAmt *UsamountType `xml:"EmpwrZoneAndComEmploymentCrAmt"`
-
-	NetIncomeTaxLessPctExcessAmt *UsamountNntype `xml:"NetIncomeTaxLessPctExcessAmt"`
-
-	SumSmllrEmpwrZnEmplmnCrAmt *UsamountType `xml:"SumSmllrEmpwrZnEmplmnCrAmt"`
-
-	NetSmllrAndEmpwrZnEmplmnCrAmt *UsamountNntype `xml:"NetSmllrAndEmpwrZnEmplmnCrAmt"`
-
-	AllwGenBusCrFromNonPssvActyAmt *UsamountNntype `xml:"AllwGenBusCrFromNonPssvActyAmt"`
-
-	GenBusEligSmllBusPssvActyCrAmt *UsamountNntype `xml:"GenBusEligSmllBusPssvActyCrAmt"`
-
-	OtherSpecifiedAllwGenBusCrAmt *UsamountNntype `xml:"OtherSpecifiedAllwGenBusCrAmt"`
-
-	AllwGenAndEligSmllBusCfwdCrAmt *Irs3800AllwGenAndEligSmllBusCfwdCrAmt `xml:"AllwGenAndEligSmllBusCfwdCrAmt"`
-
-	AllwGenAndEligSmllBusCybkCrAmt *UsamountNntype `xml:"AllwGenAndEligSmllBusCybkCrAmt"`
-
-	TotAllwGenAndEligSmllBusCrAmt *UsamountNntype `xml:"TotAllwGenAndEligSmllBusCrAmt"`
-
-	SmllrGenBusCrOrTotGenEligCrAmt *UsamountNntype `xml:"SmllrGenBusCrOrTotGenEligCrAmt"`
-
-	CurrentYearCreditAllowedAmt *Irs3800CurrentYearCreditAllowedAmt `xml:"CurrentYearCreditAllowedAmt"`
-
-	Form3468PartIicycreditsGrp *Irs3800Form3468PartIicycreditsGrp `xml:"Form3468PartIICYCreditsGrp"`
-
-	Form7207CycreditsGrp *Irs3800Form7207CycreditsGrp `xml:"Form7207CYCreditsGrp"`
-
-	Form6765CycreditsGrp *Irs3800Form6765CycreditsGrp `xml:"Form6765CYCreditsGrp"`
-
-	Form3468PartIiicycreditsGrp *Irs3800Form3468PartIiicycreditsGrp `xml:"Form3468PartIIICYCreditsGrp"`
-
-	Form8826CycreditsGrp *Irs3800Form8826CycreditsGrp `xml:"Form8826CYCreditsGrp"`
-
-	Form8835PartIicycreditsGrp *Irs3800Form8835PartIicycreditsGrp `xml:"Form8835PartIICYCreditsGrp"`
-
-	Form7210CycreditsGrp *Irs3800Form7210CycreditsGrp `xml:"Form7210CYCreditsGrp"`
-
-	Form8820CycreditsGrp *Irs3800Form8820CycreditsGrp `xml:"Form8820CYCreditsGrp"`
-
-	Form8874CycreditsGrp *Irs3800Form8874CycreditsGrp `xml:"Form8874CYCreditsGrp"`
-
-	Form8881PartIcycreditsGrp *Irs3800Form8881PartIcycreditsGrp `xml:"Form8881PartICYCreditsGrp"`
-
-	Form8882CycreditsGrp *Irs3800Form8882CycreditsGrp `xml:"Form8882CYCreditsGrp"`
-
-	Form8864CycreditsGrp *Irs3800Form8864CycreditsGrp `xml:"Form8864CYCreditsGrp"`
-
-	Form8896CycreditsGrp *Irs3800Form8896CycreditsGrp `xml:"Form8896CYCreditsGrp"`
-
-	Form8906CycreditsGrp *Irs3800Form8906CycreditsGrp `xml:"Form8906CYCreditsGrp"`
-
-	Form3468PartIvcycreditsGrp *Cygbcpssv2GrpType `xml:"Form3468PartIVCYCreditsGrp"`
-
-	Form8908CycreditsGrp *Irs3800Form8908CycreditsGrp `xml:"Form8908CYCreditsGrp"`
-
-	Form7218PartIicycreditsGrp *Irs3800Form7218PartIicycreditsGrp `xml:"Form7218PartIICYCreditsGrp"`
-
-	Form8911PartIcycreditsGrp *Irs3800Form8911PartIcycreditsGrp `xml:"Form8911PartICYCreditsGrp"`
-
-	Form8830CycreditsGrp *Irs3800Form8830CycreditsGrp `xml:"Form8830CYCreditsGrp"`
-
-	Form7213PartIicycreditsGrp *Irs3800Form7213PartIicycreditsGrp `xml:"Form7213PartIICYCreditsGrp"`
-
-	Form3468PartVcycreditsGrp *Irs3800Form3468PartVcycreditsGrp `xml:"Form3468PartVCYCreditsGrp"`
-
-	Form8932CycreditsGrp *Irs3800Form8932CycreditsGrp `xml:"Form8932CYCreditsGrp"`
-
-	Form8933CycreditsGrp *Irs3800Form8933CycreditsGrp `xml:"Form8933CYCreditsGrp"`
-
-	Form8936PartIicycreditsGrp *Irs3800Form8936PartIicycreditsGrp `xml:"Form8936PartIICYCreditsGrp"`
-
-	Form8936PartVcycreditsGrp *Irs3800Form8936PartVcycreditsGrp `xml:"Form8936PartVCYCreditsGrp"`
-
-	Form8904CycreditsGrp *Irs3800Form8904CycreditsGrp `xml:"Form8904CYCreditsGrp"`
-
-	Form7213PartIcycreditsGrp *Irs3800Form7213PartIcycreditsGrp `xml:"Form7213PartICYCreditsGrp"`
-
-	Form8881PartIicycreditsGrp *Irs3800Form8881PartIicycreditsGrp `xml:"Form8881PartIICYCreditsGrp"`
-
-	Form8881PartIiicycreditsGrp *Irs3800Form8881PartIiicycreditsGrp `xml:"Form8881PartIIICYCreditsGrp"`
-
-	Frm8864SafcycreditsGrp *Irs3800Frm8864SafcycreditsGrp `xml:"Frm8864SAFCYCreditsGrp"`
-
-	Form7211PartIicycreditsGrp *Irs3800Form7211PartIicycreditsGrp `xml:"Form7211PartIICYCreditsGrp"`
-
-	GenBusCyotherCreditsGrp *Irs3800GenBusCyotherCreditsGrp `xml:"GenBusCYOtherCreditsGrp"`
-
-	GenBusCycreditsSubTotGrp *TotGbcycrGrpType `xml:"GenBusCYCreditsSubTotGrp"`
-
-	Form8844CycreditsGrp *Irs3800Form8844CycreditsGrp `xml:"Form8844CYCreditsGrp"`
-
-	Form3468PartVicycreditsGrp *Irs3800Form3468PartVicycreditsGrp `xml:"Form3468PartVICYCreditsGrp"`
-
-	Form5884CycreditsGrp *Irs3800Form5884CycreditsGrp `xml:"Form5884CYCreditsGrp"`
-
-	Form6478CycreditsGrp *Irs3800Form6478CycreditsGrp `xml:"Form6478CYCreditsGrp"`
-
-	Form8586CycreditsGrp *Irs3800Form8586CycreditsGrp `xml:"Form8586CYCreditsGrp"`
-
-	Frm8835PartIicyspcfdCreditsGrp *Irs3800Frm8835PartIicyspcfdCreditsGrp `xml:"Frm8835PartIICYSpcfdCreditsGrp"`
-
-	Form8846CycreditsGrp *Irs3800Form8846CycreditsGrp `xml:"Form8846CYCreditsGrp"`
-
-	Form8900CycreditsGrp *Irs3800Form8900CycreditsGrp `xml:"Form8900CYCreditsGrp"`
-
-	Form8941CycreditsGrp *Irs3800Form8941CycreditsGrp `xml:"Form8941CYCreditsGrp"`
-
-	Form6765EsbcycreditsGrp *Irs3800Form6765EsbcycreditsGrp `xml:"Form6765ESBCYCreditsGrp"`
-
-	Form8994CycreditsGrp *Irs3800Form8994CycreditsGrp `xml:"Form8994CYCreditsGrp"`
-
-	Form3468PartViicycreditsGrp *Irs3800Form3468PartViicycreditsGrp `xml:"Form3468PartVIICYCreditsGrp"`
-
-	GenBusOtherSpecifiedCycrGrp *CygbcpssvGrpType `xml:"GenBusOtherSpecifiedCYCrGrp"`
-
-	GenBusCycreditsSubTot2Grp *TotGbcycrGrpType `xml:"GenBusCYCreditsSubTot2Grp"`
-
-	TotGenBusCycreditAmtGrp *TotGbcycrGrpType `xml:"TotGenBusCYCreditAmtGrp"`
-
-	Frm3468PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIICYCyovCrGrp"`
-
-	Frm7207CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7207CYCyovCrGrp"`
-
-	Frm6765CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm6765CYCyovCrGrp"`
-
-	Frm3468PartIiicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIIICYCyovCrGrp"`
-
-	Frm8826CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8826CYCyovCrGrp"`
-
-	Frm8835PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8835PartIICYCyovCrGrp"`
-
-	Frm7210CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7210CYCyovCrGrp"`
-
-	Frm8820CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8820CYCyovCrGrp"`
-
-	Frm8874CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8874CYCyovCrGrp"`
-
-	Frm8881PartIcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartICYCyovCrGrp"`
-
-	Frm8882CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8882CYCyovCrGrp"`
-
-	Frm8864BiodieselCycreditsGrp *CyovCygenBusCrGrpType `xml:"Frm8864BiodieselCYCreditsGrp"`
-
-	Frm8896CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8896CYCyovCrGrp"`
-
-	Frm8906CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8906CYCyovCrGrp"`
-
-	Frm3468PartIvcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIVCYCyovCrGrp"`
-
-	Frm8908CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8908CYCyovCrGrp"`
-
-	Frm8911CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8911CYCyovCrGrp"`
-
-	Frm8830CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8830CYCyovCrGrp"`
-
-	Frm7213PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7213PartIICYCyovCrGrp"`
-
-	Frm3468PartVcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVCYCyovCrGrp"`
-
-	Frm8932CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8932CYCyovCrGrp"`
-
-	Frm8933CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8933CYCyovCrGrp"`
-
-	Frm8936PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8936PartIICYCyovCrGrp"`
-
-	Frm8936PartVcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8936PartVCYCyovCrGrp"`
-
-	Frm8904CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8904CYCyovCrGrp"`
-
-	Frm7213PartIcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7213PartICYCyovCrGrp"`
-
-	Frm8881PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartIICYCyovCrGrp"`
-
-	Frm8881PartIiicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartIIICYCyovCrGrp"`
-
-	Frm8864SafcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8864SAFCYCyovCrGrp"`
-
-	CycyovOtherBusCreditsGrp *CyovCygenBusCrGrpType `xml:"CYCyovOtherBusCreditsGrp"`
-
-	Frm5884AcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884ACYCfwdAllwCrGrp"`
-
-	Frm8586CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8586CYCfwdAllwCrGrp"`
-
-	Frm8845CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8845CYCfwdAllwCrGrp"`
-
-	Frm8907CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8907CYCfwdAllwCrGrp"`
-
-	Frm8909CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8909CYCfwdAllwCrGrp"`
-
-	Frm8923CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8923CYCfwdAllwCrGrp"`
-
-	Frm8834PartIcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8834PartICYCfwdAllwCrGrp"`
-
-	Frm8931CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8931CYCfwdAllwCrGrp"`
-
-	Frm1065BcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm1065BCYCfwdAllwCrGrp"`
-
-	Frm5884CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884CYCfwdAllwCrGrp"`
-
-	Frm6478CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm6478CYCfwdAllwCrGrp"`
-
-	Frm8846CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8846CYCfwdAllwCrGrp"`
-
-	Frm8900CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8900CYCfwdAllwCrGrp"`
-
-	CycfwdAllwCrTransAkpipelineGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrTransAKPipelineGrp"`
-
-	CycfwdAllwCrEmplrAffctHrrcnGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrEmplrAffctHrrcnGrp"`
-
-	CycfwdAllwCrHrrcnKtrnHsngGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrHrrcnKtrnHsngGrp"`
-
-	CycfwdAllwCrMwdDsstrEmplrGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrMwdDsstrEmplrGrp"`
-
-	CycfwdAllwCrEmplrHsngGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrEmplrHsngGrp"`
-
-	Frm5884BcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884BCYCfwdAllwCrGrp"`
-
-	Frm8847CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8847CYCfwdAllwCrGrp"`
-
-	Frm8861CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8861CYCfwdAllwCrGrp"`
-
-	Frm8884CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8884CYCfwdAllwCrGrp"`
-
-	Frm8942CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8942CYCfwdAllwCrGrp"`
-
-	Frm8910CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8910CYCfwdAllwCrGrp"`
-
-	CycfwdAllwOtherBusCreditsGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwOtherBusCreditsGrp"`
-
-	Frm8844CycrovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8844CYCrovCrGrp"`
-
-	Frm3468PartVicyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVICYSpcfdCrGrp"`
-
-	Frm5884CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884CYSpcfdCrGrp"`
-
-	Frm6478CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm6478CYSpcfdCrGrp"`
-
-	Frm8586CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8586CYSpcfdCrGrp"`
-
-	Frm8835CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8835CYSpcfdCrGrp"`
-
-	Frm8846CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8846CYSpcfdCrGrp"`
-
-	Frm8900CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8900CYSpcfdCrGrp"`
-
-	Frm8941CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8941CYSpcfdCrGrp"`
-
-	Frm6765EsbcyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm6765ESBCYSpcfdCrGrp"`
-
-	Frm8994CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8994CYSpcfdCrGrp"`
-
-	Frm3468PartViicyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVIICYSpcfdCrGrp"`
-
-	EscbcycreditsGrp *CyovCygenBusCrGrpType `xml:"ESCBCYCreditsGrp"`
-
-	CyotherSpcfdCreditsGrp *CyovCygenBusCrGrpType `xml:"CYOtherSpcfdCreditsGrp"`
-
-	CyotherSpcfdCreditsSubTotGrp *TotalCyovCybusCreditType `xml:"CYOtherSpcfdCreditsSubTotGrp"`
-
-	TotCygbcorEsbcamtGrp *TotalCyovCybusCreditType `xml:"TotCYGBCOrESBCAmtGrp"`
-
-	Tot8844OthSpcfdGbcorEsbcamtGrp *TotalCyovCybusCreditType `xml:"Tot8844OthSpcfdGBCOrESBCAmtGrp"`
-
-	GbcbreakdownCyaggrgtAmtGrp *Irs3800GbcbreakdownCyaggrgtAmtGrp `xml:"GBCBreakdownCYAggrgtAmtGrp"`
-
-	Frm3468PartIicycyovCrAggrgtGrp *Irs3800Frm3468PartIicycyovCrAggrgtGrp `xml:"Frm3468PartIICYCyovCrAggrgtGrp"`
-
-	Frm7207CycyovCrAggrgtGrp *Irs3800Frm7207CycyovCrAggrgtGrp `xml:"Frm7207CYCyovCrAggrgtGrp"`
-
-	Frm6765CycyovCrAggrgtGrp *Irs3800Frm6765CycyovCrAggrgtGrp `xml:"Frm6765CYCyovCrAggrgtGrp"`
-
-	Frm3468PartIiicyovCrAggrgtGrp *Irs3800Frm3468PartIiicyovCrAggrgtGrp `xml:"Frm3468PartIIICyovCrAggrgtGrp"`
-
-	Frm8826CycyovCrAggrgtGrp *Irs3800Frm8826CycyovCrAggrgtGrp `xml:"Frm8826CYCyovCrAggrgtGrp"`
-
-	Frm8835PartIicycyovCrAggrgtGrp *Irs3800Frm8835PartIicycyovCrAggrgtGrp `xml:"Frm8835PartIICYCyovCrAggrgtGrp"`
-
-	Frm7210CycyovCrAggrgtGrp *Irs3800Frm7210CycyovCrAggrgtGrp `xml:"Frm7210CYCyovCrAggrgtGrp"`
-
-	Frm8820CycyovCrAggrgtGrp *Irs3800Frm8820CycyovCrAggrgtGrp `xml:"Frm8820CYCyovCrAggrgtGrp"`
-
-	Frm8874CycyovCrAggrgtGrp *Irs3800Frm8874CycyovCrAggrgtGrp `xml:"Frm8874CYCyovCrAggrgtGrp"`
-
-	Frm8881PartIcycyovCrAggrgtGrp *Irs3800Frm8881PartIcycyovCrAggrgtGrp `xml:"Frm8881PartICYCyovCrAggrgtGrp"`
-
-	Frm8882CycyovCrAggrgtGrp *Irs3800Frm8882CycyovCrAggrgtGrp `xml:"Frm8882CYCyovCrAggrgtGrp"`
-
-	Frm8864BdslCycyovCrAggrgtGrp *Irs3800Frm8864BdslCycyovCrAggrgtGrp `xml:"Frm8864BdslCYCyovCrAggrgtGrp"`
-
-	Frm8896CycyovCrAggrgtGrp *Irs3800Frm8896CycyovCrAggrgtGrp `xml:"Frm8896CYCyovCrAggrgtGrp"`
-
-	Frm8906CycyovCrAggrgtGrp *Irs3800Frm8906CycyovCrAggrgtGrp `xml:"Frm8906CYCyovCrAggrgtGrp"`
-
-	Frm3468PartIvcycyovCrAggrgtGrp *Irs3800Frm3468PartIvcycyovCrAggrgtGrp `xml:"Frm3468PartIVCYCyovCrAggrgtGrp"`
-
-	Frm8908CycyovCrAggrgtGrp *Irs3800Frm8908CycyovCrAggrgtGrp `xml:"Frm8908CYCyovCrAggrgtGrp"`
-
-	Frm8911CycyovCrAggrgtGrp *Irs3800Frm8911CycyovCrAggrgtGrp `xml:"Frm8911CYCyovCrAggrgtGrp"`
-
-	Frm8830CycyovCrAggrgtGrp *Irs3800Frm8830CycyovCrAggrgtGrp `xml:"Frm8830CYCyovCrAggrgtGrp"`
-
-	Frm7213PartIicycyovCrAggrgtGrp *Irs3800Frm7213PartIicycyovCrAggrgtGrp `xml:"Frm7213PartIICYCyovCrAggrgtGrp"`
-
-	Frm3468PartVcycyovCrAggrgtGrp *Irs3800Frm3468PartVcycyovCrAggrgtGrp `xml:"Frm3468PartVCYCyovCrAggrgtGrp"`
-
-	Frm8932CycyovCrAggrgtGrp *Irs3800Frm8932CycyovCrAggrgtGrp `xml:"Frm8932CYCyovCrAggrgtGrp"`
-
-	Frm8933CycyovCrAggrgtGrp *Irs3800Frm8933CycyovCrAggrgtGrp `xml:"Frm8933CYCyovCrAggrgtGrp"`
-
-	Frm8936PartIicycyovCrAggrgtGrp *Irs3800Frm8936PartIicycyovCrAggrgtGrp `xml:"Frm8936PartIICYCyovCrAggrgtGrp"`
-
-	Frm8936PartVcycyovCrAggrgtGrp *Irs3800Frm8936PartVcycyovCrAggrgtGrp `xml:"Frm8936PartVCYCyovCrAggrgtGrp"`
-
-	Frm8904CycyovCrAggrgtGrp *Irs3800Frm8904CycyovCrAggrgtGrp `xml:"Frm8904CYCyovCrAggrgtGrp"`
-
-	Frm7213PartIcycyovCrAggrgtGrp *Irs3800Frm7213PartIcycyovCrAggrgtGrp `xml:"Frm7213PartICYCyovCrAggrgtGrp"`
-
-	Frm8881PartIicycyovCrAggrgtGrp *Irs3800Frm8881PartIicycyovCrAggrgtGrp `xml:"Frm8881PartIICYCyovCrAggrgtGrp"`
-
-	Frm8881PartIiicycyovCrAggrgGrp *Irs3800Frm8881PartIiicycyovCrAggrgGrp `xml:"Frm8881PartIIICYCyovCrAggrgGrp"`
-
-	Frm8864SafcycyovCrAggrgtGrp *Irs3800Frm8864SafcycyovCrAggrgtGrp `xml:"Frm8864SAFCYCyovCrAggrgtGrp"`
-
-	CycyovOtherBusCreditsAggrgtGrp *Irs3800CycyovOtherBusCreditsAggrgtGrp `xml:"CYCyovOtherBusCreditsAggrgtGrp"`
-
-	Frm5884AcycfwdAllwCrAggrgtGrp *Irs3800Frm5884AcycfwdAllwCrAggrgtGrp `xml:"Frm5884ACYCfwdAllwCrAggrgtGrp"`
-
-	Frm8586CycfwdAllwCrAggrgtGrp *Irs3800Frm8586CycfwdAllwCrAggrgtGrp `xml:"Frm8586CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8845CycfwdAllwCrAggrgtGrp *Irs3800Frm8845CycfwdAllwCrAggrgtGrp `xml:"Frm8845CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8907CycfwdAllwCrAggrgtGrp *Irs3800Frm8907CycfwdAllwCrAggrgtGrp `xml:"Frm8907CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8909CycfwdAllwCrAggrgtGrp *Irs3800Frm8909CycfwdAllwCrAggrgtGrp `xml:"Frm8909CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8923CycfwdAllwCrAggrgtGrp *Irs3800Frm8923CycfwdAllwCrAggrgtGrp `xml:"Frm8923CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8834CycfwdCrAggrgtGrp *Irs3800Frm8834CycfwdCrAggrgtGrp `xml:"Frm8834CYCfwdCrAggrgtGrp"`
-
-	Frm8931CycfwdAllwCrAggrgtGrp *Irs3800Frm8931CycfwdAllwCrAggrgtGrp `xml:"Frm8931CYCfwdAllwCrAggrgtGrp"`
-
-	Frm1065BcycfwdAllwCrAggrgtGrp *Irs3800Frm1065BcycfwdAllwCrAggrgtGrp `xml:"Frm1065BCYCfwdAllwCrAggrgtGrp"`
-
-	Frm5884CycfwdAllwCrAggrgtGrp *Irs3800Frm5884CycfwdAllwCrAggrgtGrp `xml:"Frm5884CYCfwdAllwCrAggrgtGrp"`
-
-	Frm6478CycfwdAllwCrAggrgtGrp *Irs3800Frm6478CycfwdAllwCrAggrgtGrp `xml:"Frm6478CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8846CycfwdAllwCrAggrgtGrp *Irs3800Frm8846CycfwdAllwCrAggrgtGrp `xml:"Frm8846CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8900CycfwdAllwCrAggrgtGrp *Irs3800Frm8900CycfwdAllwCrAggrgtGrp `xml:"Frm8900CYCfwdAllwCrAggrgtGrp"`
-
-	CycfwdAllwTrAkpplnAggrgtGrp *Irs3800CycfwdAllwTrAkpplnAggrgtGrp `xml:"CYCfwdAllwTrAKPplnAggrgtGrp"`
-
-	CycfwdEmplrAffctHrrcnAggrgtGrp *Irs3800CycfwdEmplrAffctHrrcnAggrgtGrp `xml:"CYCfwdEmplrAffctHrrcnAggrgtGrp"`
-
-	CycfwdAllwKtrnHsngAggrgtGrp *Irs3800CycfwdAllwKtrnHsngAggrgtGrp `xml:"CYCfwdAllwKtrnHsngAggrgtGrp"`
-
-	CycfwdCrMwdDsstrEmplrAggrgtGrp *Irs3800CycfwdCrMwdDsstrEmplrAggrgtGrp `xml:"CYCfwdCrMwdDsstrEmplrAggrgtGrp"`
-
-	CycfwdAllwCrEmplrHsngAggrgtGrp *Irs3800CycfwdAllwCrEmplrHsngAggrgtGrp `xml:"CYCfwdAllwCrEmplrHsngAggrgtGrp"`
-
-	Frm5884BcycfwdAllwCrAggrgtGrp *Irs3800Frm5884BcycfwdAllwCrAggrgtGrp `xml:"Frm5884BCYCfwdAllwCrAggrgtGrp"`
-
-	Frm8847CycfwdAllwCrAggrgtGrp *Irs3800Frm8847CycfwdAllwCrAggrgtGrp `xml:"Frm8847CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8861CycfwdAllwCrAggrgtGrp *Irs3800Frm8861CycfwdAllwCrAggrgtGrp `xml:"Frm8861CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8884CycfwdAllwCrAggrgtGrp *Irs3800Frm8884CycfwdAllwCrAggrgtGrp `xml:"Frm8884CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8942CycfwdAllwCrAggrgtGrp *Irs3800Frm8942CycfwdAllwCrAggrgtGrp `xml:"Frm8942CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8910CycfwdAllwCrAggrgtGrp *Irs3800Frm8910CycfwdAllwCrAggrgtGrp `xml:"Frm8910CYCfwdAllwCrAggrgtGrp"`
-
-	CycfwdAllwOtherBusCrAggrgtGrp *Irs3800CycfwdAllwOtherBusCrAggrgtGrp `xml:"CYCfwdAllwOtherBusCrAggrgtGrp"`
-
-	Frm8844CycyovCrAggrgtGrp *Irs3800Frm8844CycyovCrAggrgtGrp `xml:"Frm8844CYCyovCrAggrgtGrp"`
-
-	Frm3468PartVicyspfdCrAggrgtGrp *Irs3800Frm3468PartVicyspfdCrAggrgtGrp `xml:"Frm3468PartVICYSpfdCrAggrgtGrp"`
-
-	Frm5884CyspcfdCrAggrgtGrp *Irs3800Frm5884CyspcfdCrAggrgtGrp `xml:"Frm5884CYSpcfdCrAggrgtGrp"`
-
-	Frm6478CyspcfdCrAggrgtGrp *Irs3800Frm6478CyspcfdCrAggrgtGrp `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	Frm8586CyspcfdCrAggrgtGrp *Irs3800Frm8586CyspcfdCrAggrgtGrp `xml:"Frm8586CYSpcfdCrAggrgtGrp"`
-
-	Frm8835CyspcfdCrAggrgtGrp *Irs3800Frm8835CyspcfdCrAggrgtGrp `xml:"Frm8835CYSpcfdCrAggrgtGrp"`
-
-	Frm8846CyspcfdCrAggrgtGrp *Irs3800Frm8846CyspcfdCrAggrgtGrp `xml:"Frm8846CYSpcfdCrAggrgtGrp"`
-
-	Frm8900CyspcfdCrAggrgtGrp *Irs3800Frm8900CyspcfdCrAggrgtGrp `xml:"Frm8900CYSpcfdCrAggrgtGrp"`
-
-	Frm8941CyspcfdCrAggrgtGrp *Irs3800Frm8941CyspcfdCrAggrgtGrp `xml:"Frm8941CYSpcfdCrAggrgtGrp"`
-
-	Frm6765EsbcyspcfdCrAggrgtGrp *Irs3800Frm6765EsbcyspcfdCrAggrgtGrp `xml:"Frm6765ESBCYSpcfdCrAggrgtGrp"`
-
-	Frm8994CyspcfdCrAggrgtGrp *Irs3800Frm8994CyspcfdCrAggrgtGrp `xml:"Frm8994CYSpcfdCrAggrgtGrp"`
-
-	Frm3468ViicyspcfdCrAggrgtGrp *Irs3800Frm3468ViicyspcfdCrAggrgtGrp `xml:"Frm3468VIICYSpcfdCrAggrgtGrp"`
-
-	EsbccyspcfdCrAggrgtGrp *Irs3800EsbccyspcfdCrAggrgtGrp `xml:"ESBCCYSpcfdCrAggrgtGrp"`
-
-	CyotherSpcfdCreditsAggrgtGrp *Irs3800CyotherSpcfdCreditsAggrgtGrp `xml:"CYOtherSpcfdCreditsAggrgtGrp"`
-
-	CyotherSpcfdCrSubTotAggrgtGrp *Irs3800CyotherSpcfdCrSubTotAggrgtGrp `xml:"CYOtherSpcfdCrSubTotAggrgtGrp"`
-
-	TotCygbcorEsbcamtAggrgtGrp *Irs3800TotCygbcorEsbcamtAggrgtGrp `xml:"TotCYGBCOrESBCAmtAggrgtGrp"`
-
-	Tot8844GbcorEsbcaggrgtAmtGrp *Irs3800Tot8844GbcorEsbcaggrgtAmtGrp `xml:"Tot8844GBCOrESBCAggrgtAmtGrp"`
-}
-
-// Element
-type Irs3800CygeneralBusCrCarryforwardAmt struct {
-	XMLName xml.Name `xml:"CYGeneralBusCrCarryforwardAmt"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CarryforwardChgdOrRevsInd CheckboxType `xml:"carryforwardChgdOrRevsInd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800SmllrCynotAllwTmtorTotAdjAmt struct {
-	XMLName xml.Name `xml:"SmllrCYNotAllwTMTOrTotAdjAmt"`
-
-	Section383Or384IndicatorCd string `xml:"section383Or384IndicatorCd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800AllwGenAndEligSmllBusCfwdCrAmt struct {
-	XMLName xml.Name `xml:"AllwGenAndEligSmllBusCfwdCrAmt"`
-
-	CarryforwardChgdOrRevsInd CheckboxType `xml:"carryforwardChgdOrRevsInd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800CurrentYearCreditAllowedAmt struct {
-	XMLName xml.Name `xml:"CurrentYearCreditAllowedAmt"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800Form3468PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7207CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7207CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6765CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6765CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartIiicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartIIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8826CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8826CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8835PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8835PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7210CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7210CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8820CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8820CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8874CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8874CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8882CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8882CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8864CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8864CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8896CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8896CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8906CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8906CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8908CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8908CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7218PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7218PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8911PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8911PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8830CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8830CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7213PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7213PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartVcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8932CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8932CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8933CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8933CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8936PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8936PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8936PartVcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8936PartVCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8904CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8904CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7213PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7213PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIiicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartIIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864SafcycreditsGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7211PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7211PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GenBusCyotherCreditsGrp struct {
-	XMLName xml.Name `xml:"GenBusCYOtherCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8844CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8844CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartVicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form5884CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form5884CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6478CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6478CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8586CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8586CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835PartIicyspcfdCreditsGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYSpcfdCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8846CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8846CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8900CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8900CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8941CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8941CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6765EsbcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6765ESBCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8994CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8994CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartViicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7207CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7207CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm6765CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm6765CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartIiicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8826CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8826CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7210CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7210CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8820CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8820CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8874CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8874CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8881PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8882CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8882CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8864CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8864CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8896CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8896CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8906CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8906CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartIvcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8908CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8908CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7218PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7218PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8911PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8911PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8830CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8830CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7213PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartVcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8932CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8932CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8933CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8933CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8936PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8936PartVcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8904CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8904CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7213PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8881PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8881PartIiicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8864SafcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm7211PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7211PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpGenBusOtherCrCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GenBusOtherCrCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTotGrp struct {
-	XMLName xml.Name `xml:"GenBusCYAggrgtSubTotGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8844CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8844CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartVicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm5884CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm6478CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8586CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyspcfdAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYSpcfdAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8846CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8900CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8941CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8941CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm6765EsbcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm6765ESBCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm8994CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8994CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpFrm3468PartViicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpGenBusOthSpcfdCrCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GenBusOthSpcfdCrCYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTot2Grp struct {
-	XMLName xml.Name `xml:"GenBusCYAggrgtSubTot2Grp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type GbcbreakdownCyaggrgtAmtGrpTotGenBusCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"TotGenBusCYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GBCBreakdownCYAggrgtAmtGrp"`
-
-	Frm3468PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIicyaggrgtAmtGrp `xml:"Frm3468PartIICYAggrgtAmtGrp"`
-
-	Frm7207CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7207CyaggrgtAmtGrp `xml:"Frm7207CYAggrgtAmtGrp"`
-
-	Frm6765CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765CyaggrgtAmtGrp `xml:"Frm6765CYAggrgtAmtGrp"`
-
-	Frm3468PartIiicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIiicyaggrgtAmtGrp `xml:"Frm3468PartIIICYAggrgtAmtGrp"`
-
-	Frm8826CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8826CyaggrgtAmtGrp `xml:"Frm8826CYAggrgtAmtGrp"`
-
-	Frm8835PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyaggrgtAmtGrp `xml:"Frm8835PartIICYAggrgtAmtGrp"`
-
-	Frm7210CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7210CyaggrgtAmtGrp `xml:"Frm7210CYAggrgtAmtGrp"`
-
-	Frm8820CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8820CyaggrgtAmtGrp `xml:"Frm8820CYAggrgtAmtGrp"`
-
-	Frm8874CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8874CyaggrgtAmtGrp `xml:"Frm8874CYAggrgtAmtGrp"`
-
-	Frm8881PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIcyaggrgtAmtGrp `xml:"Frm8881PartICYAggrgtAmtGrp"`
-
-	Frm8882CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8882CyaggrgtAmtGrp `xml:"Frm8882CYAggrgtAmtGrp"`
-
-	Frm8864CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864CyaggrgtAmtGrp `xml:"Frm8864CYAggrgtAmtGrp"`
-
-	Frm8896CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8896CyaggrgtAmtGrp `xml:"Frm8896CYAggrgtAmtGrp"`
-
-	Frm8906CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8906CyaggrgtAmtGrp `xml:"Frm8906CYAggrgtAmtGrp"`
-
-	Frm3468PartIvcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIvcyaggrgtAmtGrp `xml:"Frm3468PartIVCYAggrgtAmtGrp"`
-
-	Frm8908CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8908CyaggrgtAmtGrp `xml:"Frm8908CYAggrgtAmtGrp"`
-
-	Frm7218PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7218PartIicyaggrgtAmtGrp `xml:"Frm7218PartIICYAggrgtAmtGrp"`
-
-	Frm8911PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8911PartIcyaggrgtAmtGrp `xml:"Frm8911PartICYAggrgtAmtGrp"`
-
-	Frm8830CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8830CyaggrgtAmtGrp `xml:"Frm8830CYAggrgtAmtGrp"`
-
-	Frm7213PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIicyaggrgtAmtGrp `xml:"Frm7213PartIICYAggrgtAmtGrp"`
-
-	Frm3468PartVcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVcyaggrgtAmtGrp `xml:"Frm3468PartVCYAggrgtAmtGrp"`
-
-	Frm8932CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8932CyaggrgtAmtGrp `xml:"Frm8932CYAggrgtAmtGrp"`
-
-	Frm8933CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8933CyaggrgtAmtGrp `xml:"Frm8933CYAggrgtAmtGrp"`
-
-	Frm8936PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartIicyaggrgtAmtGrp `xml:"Frm8936PartIICYAggrgtAmtGrp"`
-
-	Frm8936PartVcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartVcyaggrgtAmtGrp `xml:"Frm8936PartVCYAggrgtAmtGrp"`
-
-	Frm8904CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8904CyaggrgtAmtGrp `xml:"Frm8904CYAggrgtAmtGrp"`
-
-	Frm7213PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIcyaggrgtAmtGrp `xml:"Frm7213PartICYAggrgtAmtGrp"`
-
-	Frm8881PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIicyaggrgtAmtGrp `xml:"Frm8881PartIICYAggrgtAmtGrp"`
-
-	Frm8881PartIiicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIiicyaggrgtAmtGrp `xml:"Frm8881PartIIICYAggrgtAmtGrp"`
-
-	Frm8864SafcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864SafcyaggrgtAmtGrp `xml:"Frm8864SAFCYAggrgtAmtGrp"`
-
-	Frm7211PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7211PartIicyaggrgtAmtGrp `xml:"Frm7211PartIICYAggrgtAmtGrp"`
-
-	GenBusOtherCrCyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOtherCrCyaggrgtAmtGrp `xml:"GenBusOtherCrCYAggrgtAmtGrp"`
-
-	GenBusCyaggrgtSubTotGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTotGrp `xml:"GenBusCYAggrgtSubTotGrp"`
-
-	Frm8844CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8844CyaggrgtAmtGrp `xml:"Frm8844CYAggrgtAmtGrp"`
-
-	Frm3468PartVicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVicyaggrgtAmtGrp `xml:"Frm3468PartVICYAggrgtAmtGrp"`
-
-	Frm5884CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm5884CyaggrgtAmtGrp `xml:"Frm5884CYAggrgtAmtGrp"`
-
-	Frm6478CyspcfdCrAggrgtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6478CyspcfdCrAggrgtGrp `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	Frm8586CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8586CyaggrgtAmtGrp `xml:"Frm8586CYAggrgtAmtGrp"`
-
-	Frm8835PartIicyspcfdAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyspcfdAmtGrp `xml:"Frm8835PartIICYSpcfdAmtGrp"`
-
-	Frm8846CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8846CyaggrgtAmtGrp `xml:"Frm8846CYAggrgtAmtGrp"`
-
-	Frm8900CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8900CyaggrgtAmtGrp `xml:"Frm8900CYAggrgtAmtGrp"`
-
-	Frm8941CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8941CyaggrgtAmtGrp `xml:"Frm8941CYAggrgtAmtGrp"`
-
-	Frm6765EsbcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765EsbcyaggrgtAmtGrp `xml:"Frm6765ESBCYAggrgtAmtGrp"`
-
-	Frm8994CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8994CyaggrgtAmtGrp `xml:"Frm8994CYAggrgtAmtGrp"`
-
-	Frm3468PartViicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartViicyaggrgtAmtGrp `xml:"Frm3468PartVIICYAggrgtAmtGrp"`
-
-	GenBusOthSpcfdCrCyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOthSpcfdCrCyaggrgtAmtGrp `xml:"GenBusOthSpcfdCrCYAggrgtAmtGrp"`
-
-	GenBusCyaggrgtSubTot2Grp *Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTot2Grp `xml:"GenBusCYAggrgtSubTot2Grp"`
-
-	TotGenBusCyaggrgtAmtGrp *Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpTotGenBusCyaggrgtAmtGrp `xml:"TotGenBusCYAggrgtAmtGrp"`
-}
-
-// Element
-type Irs3800Frm3468PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7207CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7207CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6765CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6765CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartIiicyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIIICyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8826CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8826CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7210CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7210CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8820CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8820CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8874CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8874CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8882CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8882CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864BdslCycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8864BdslCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8896CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8896CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8906CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8906CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartIvcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8908CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8908CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8911CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8911CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8830CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8830CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7213PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartVcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8932CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8932CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8933CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8933CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8936PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8936PartVcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8904CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8904CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7213PartIcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIiicycyovCrAggrgGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIIICYCyovCrAggrgGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864SafcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycyovOtherBusCreditsAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCyovOtherBusCreditsAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884AcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884ACYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8586CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8845CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8845CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8907CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8907CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8909CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8909CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8923CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8923CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8834CycfwdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8834CYCfwdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8931CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8931CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm1065BcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm1065BCYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6478CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8846CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8900CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwTrAkpplnAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwTrAKPplnAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdEmplrAffctHrrcnAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdEmplrAffctHrrcnAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwKtrnHsngAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwKtrnHsngAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdCrMwdDsstrEmplrAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdCrMwdDsstrEmplrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwCrEmplrHsngAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwCrEmplrHsngAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884BcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884BCYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8847CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8847CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8861CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8861CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8884CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8884CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8942CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8942CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8910CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8910CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwOtherBusCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwOtherBusCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8844CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8844CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartVicyspfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVICYSpfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6478CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8586CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8835CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8846CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8900CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8941CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8941CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6765EsbcyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6765ESBCYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8994CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8994CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468ViicyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468VIICYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800EsbccyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"ESBCCYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Desc LineExplanationType `xml:"desc,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CyotherSpcfdCreditsAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYOtherSpcfdCreditsAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CyotherSpcfdCrSubTotAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYOtherSpcfdCrSubTotAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800TotCygbcorEsbcamtAggrgtGrp struct {
-	XMLName xml.Name `xml:"TotCYGBCOrESBCAmtAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Tot8844GbcorEsbcaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Tot8844GBCOrESBCAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CygeneralBusCrCarryforwardAmt struct {
-	XMLName xml.Name `xml:"CYGeneralBusCrCarryforwardAmt"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CarryforwardChgdOrRevsInd CheckboxType `xml:"carryforwardChgdOrRevsInd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800SmllrCynotAllwTmtorTotAdjAmt struct {
-	XMLName xml.Name `xml:"SmllrCYNotAllwTMTOrTotAdjAmt"`
-
-	Section383Or384IndicatorCd string `xml:"section383Or384IndicatorCd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800AllwGenAndEligSmllBusCfwdCrAmt struct {
-	XMLName xml.Name `xml:"AllwGenAndEligSmllBusCfwdCrAmt"`
-
-	CarryforwardChgdOrRevsInd CheckboxType `xml:"carryforwardChgdOrRevsInd,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800CurrentYearCreditAllowedAmt struct {
-	XMLName xml.Name `xml:"CurrentYearCreditAllowedAmt"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	Text string `xml:",chardata"`
-}
-
-// Element
-type Irs3800Form3468PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7207CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7207CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6765CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6765CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartIiicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartIIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8826CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8826CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8835PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8835PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7210CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7210CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8820CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8820CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8874CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8874CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8882CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8882CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8864CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8864CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8896CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8896CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8906CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8906CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8908CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8908CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7218PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7218PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8911PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8911PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8830CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8830CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7213PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7213PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartVcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8932CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8932CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8933CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8933CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8936PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8936PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8936PartVcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8936PartVCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8904CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8904CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7213PartIcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7213PartICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8881PartIiicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8881PartIIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864SafcycreditsGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form7211PartIicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form7211PartIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GenBusCyotherCreditsGrp struct {
-	XMLName xml.Name `xml:"GenBusCYOtherCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8844CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8844CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartVicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form5884CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form5884CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6478CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6478CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8586CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8586CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835PartIicyspcfdCreditsGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYSpcfdCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8846CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8846CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8900CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8900CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8941CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8941CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form6765EsbcycreditsGrp struct {
-	XMLName xml.Name `xml:"Form6765ESBCYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form8994CycreditsGrp struct {
-	XMLName xml.Name `xml:"Form8994CYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Form3468PartViicycreditsGrp struct {
-	XMLName xml.Name `xml:"Form3468PartVIICYCreditsGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7207CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7207CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm6765CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIiicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8826CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8826CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7210CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7210CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8820CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8820CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8874CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8874CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8882CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8882CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8864CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8896CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8896CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8906CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8906CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIvcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8908CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8908CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7218PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7218PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8911PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8911PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8830CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8830CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8932CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8932CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8933CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8933CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartVcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartVCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8904CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8904CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIiicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864SafcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7211PartIicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm7211PartIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOtherCrCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GenBusOtherCrCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTotGrp struct {
-	XMLName xml.Name `xml:"GenBusCYAggrgtSubTotGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8844CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8844CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm5884CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6478CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8586CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyspcfdAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYSpcfdAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8846CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8900CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8941CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8941CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765EsbcyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm6765ESBCYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8994CyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm8994CYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartViicyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVIICYAggrgtAmtGrp"`
-
-	ReferenceDocumentId IdListType `xml:"referenceDocumentId,attr"`
-
-	ReferenceDocumentName StringType `xml:"referenceDocumentName,attr"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOthSpcfdCrCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GenBusOthSpcfdCrCYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTot2Grp struct {
-	XMLName xml.Name `xml:"GenBusCYAggrgtSubTot2Grp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpTotGenBusCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"TotGenBusCYAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-// Element
-type Irs3800GbcbreakdownCyaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"GBCBreakdownCYAggrgtAmtGrp"`
-
-	Frm3468PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIicyaggrgtAmtGrp `xml:"Frm3468PartIICYAggrgtAmtGrp"`
-
-	Frm7207CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7207CyaggrgtAmtGrp `xml:"Frm7207CYAggrgtAmtGrp"`
-
-	Frm6765CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765CyaggrgtAmtGrp `xml:"Frm6765CYAggrgtAmtGrp"`
-
-	Frm3468PartIiicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIiicyaggrgtAmtGrp `xml:"Frm3468PartIIICYAggrgtAmtGrp"`
-
-	Frm8826CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8826CyaggrgtAmtGrp `xml:"Frm8826CYAggrgtAmtGrp"`
-
-	Frm8835PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyaggrgtAmtGrp `xml:"Frm8835PartIICYAggrgtAmtGrp"`
-
-	Frm7210CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7210CyaggrgtAmtGrp `xml:"Frm7210CYAggrgtAmtGrp"`
-
-	Frm8820CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8820CyaggrgtAmtGrp `xml:"Frm8820CYAggrgtAmtGrp"`
-
-	Frm8874CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8874CyaggrgtAmtGrp `xml:"Frm8874CYAggrgtAmtGrp"`
-
-	Frm8881PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIcyaggrgtAmtGrp `xml:"Frm8881PartICYAggrgtAmtGrp"`
-
-	Frm8882CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8882CyaggrgtAmtGrp `xml:"Frm8882CYAggrgtAmtGrp"`
-
-	Frm8864CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864CyaggrgtAmtGrp `xml:"Frm8864CYAggrgtAmtGrp"`
-
-	Frm8896CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8896CyaggrgtAmtGrp `xml:"Frm8896CYAggrgtAmtGrp"`
-
-	Frm8906CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8906CyaggrgtAmtGrp `xml:"Frm8906CYAggrgtAmtGrp"`
-
-	Frm3468PartIvcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartIvcyaggrgtAmtGrp `xml:"Frm3468PartIVCYAggrgtAmtGrp"`
-
-	Frm8908CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8908CyaggrgtAmtGrp `xml:"Frm8908CYAggrgtAmtGrp"`
-
-	Frm7218PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7218PartIicyaggrgtAmtGrp `xml:"Frm7218PartIICYAggrgtAmtGrp"`
-
-	Frm8911PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8911PartIcyaggrgtAmtGrp `xml:"Frm8911PartICYAggrgtAmtGrp"`
-
-	Frm8830CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8830CyaggrgtAmtGrp `xml:"Frm8830CYAggrgtAmtGrp"`
-
-	Frm7213PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIicyaggrgtAmtGrp `xml:"Frm7213PartIICYAggrgtAmtGrp"`
-
-	Frm3468PartVcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVcyaggrgtAmtGrp `xml:"Frm3468PartVCYAggrgtAmtGrp"`
-
-	Frm8932CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8932CyaggrgtAmtGrp `xml:"Frm8932CYAggrgtAmtGrp"`
-
-	Frm8933CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8933CyaggrgtAmtGrp `xml:"Frm8933CYAggrgtAmtGrp"`
-
-	Frm8936PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartIicyaggrgtAmtGrp `xml:"Frm8936PartIICYAggrgtAmtGrp"`
-
-	Frm8936PartVcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8936PartVcyaggrgtAmtGrp `xml:"Frm8936PartVCYAggrgtAmtGrp"`
-
-	Frm8904CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8904CyaggrgtAmtGrp `xml:"Frm8904CYAggrgtAmtGrp"`
-
-	Frm7213PartIcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7213PartIcyaggrgtAmtGrp `xml:"Frm7213PartICYAggrgtAmtGrp"`
-
-	Frm8881PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIicyaggrgtAmtGrp `xml:"Frm8881PartIICYAggrgtAmtGrp"`
-
-	Frm8881PartIiicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8881PartIiicyaggrgtAmtGrp `xml:"Frm8881PartIIICYAggrgtAmtGrp"`
-
-	Frm8864SafcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8864SafcyaggrgtAmtGrp `xml:"Frm8864SAFCYAggrgtAmtGrp"`
-
-	Frm7211PartIicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm7211PartIicyaggrgtAmtGrp `xml:"Frm7211PartIICYAggrgtAmtGrp"`
-
-	GenBusOtherCrCyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOtherCrCyaggrgtAmtGrp `xml:"GenBusOtherCrCYAggrgtAmtGrp"`
-
-	GenBusCyaggrgtSubTotGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTotGrp `xml:"GenBusCYAggrgtSubTotGrp"`
-
-	Frm8844CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8844CyaggrgtAmtGrp `xml:"Frm8844CYAggrgtAmtGrp"`
-
-	Frm3468PartVicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartVicyaggrgtAmtGrp `xml:"Frm3468PartVICYAggrgtAmtGrp"`
-
-	Frm5884CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm5884CyaggrgtAmtGrp `xml:"Frm5884CYAggrgtAmtGrp"`
-
-	Frm6478CyspcfdCrAggrgtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6478CyspcfdCrAggrgtGrp `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	Frm8586CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8586CyaggrgtAmtGrp `xml:"Frm8586CYAggrgtAmtGrp"`
-
-	Frm8835PartIicyspcfdAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyspcfdAmtGrp `xml:"Frm8835PartIICYSpcfdAmtGrp"`
-
-	Frm8846CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8846CyaggrgtAmtGrp `xml:"Frm8846CYAggrgtAmtGrp"`
-
-	Frm8900CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8900CyaggrgtAmtGrp `xml:"Frm8900CYAggrgtAmtGrp"`
-
-	Frm8941CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8941CyaggrgtAmtGrp `xml:"Frm8941CYAggrgtAmtGrp"`
-
-	Frm6765EsbcyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm6765EsbcyaggrgtAmtGrp `xml:"Frm6765ESBCYAggrgtAmtGrp"`
-
-	Frm8994CyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm8994CyaggrgtAmtGrp `xml:"Frm8994CYAggrgtAmtGrp"`
-
-	Frm3468PartViicyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpFrm3468PartViicyaggrgtAmtGrp `xml:"Frm3468PartVIICYAggrgtAmtGrp"`
-
-	GenBusOthSpcfdCrCyaggrgtAmtGrp []Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusOthSpcfdCrCyaggrgtAmtGrp `xml:"GenBusOthSpcfdCrCYAggrgtAmtGrp"`
-
-	GenBusCyaggrgtSubTot2Grp *Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTot2Grp `xml:"GenBusCYAggrgtSubTot2Grp"`
-
-	TotGenBusCyaggrgtAmtGrp *Irs3800GbcbreakdownCyaggrgtAmtGrpGbcbreakdownCyaggrgtAmtGrpTotGenBusCyaggrgtAmtGrp `xml:"TotGenBusCYAggrgtAmtGrp"`
-}
-
-// Element
-type Irs3800Frm3468PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7207CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7207CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6765CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6765CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartIiicyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIIICyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8826CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8826CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8835PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7210CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7210CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8820CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8820CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8874CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8874CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8882CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8882CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864BdslCycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8864BdslCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8896CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8896CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8906CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8906CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartIvcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartIVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8908CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8908CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8911CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8911CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8830CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8830CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7213PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartVcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8932CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8932CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8933CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8933CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8936PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8936PartVcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8936PartVCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8904CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8904CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm7213PartIcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm7213PartICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIicycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIICYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8881PartIiicycyovCrAggrgGrp struct {
-	XMLName xml.Name `xml:"Frm8881PartIIICYCyovCrAggrgGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8864SafcycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8864SAFCYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycyovOtherBusCreditsAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCyovOtherBusCreditsAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884AcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884ACYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8586CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8845CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8845CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8907CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8907CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8909CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8909CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8923CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8923CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8834CycfwdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8834CYCfwdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8931CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8931CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm1065BcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm1065BCYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6478CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8846CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8900CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwTrAkpplnAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwTrAKPplnAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdEmplrAffctHrrcnAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdEmplrAffctHrrcnAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwKtrnHsngAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwKtrnHsngAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdCrMwdDsstrEmplrAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdCrMwdDsstrEmplrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwCrEmplrHsngAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwCrEmplrHsngAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884BcycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884BCYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8847CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8847CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8861CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8861CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8884CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8884CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8942CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8942CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8910CycfwdAllwCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8910CYCfwdAllwCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CycfwdAllwOtherBusCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYCfwdAllwOtherBusCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8844CycyovCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8844CYCyovCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468PartVicyspfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468PartVICYSpfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm5884CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm5884CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6478CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8586CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8586CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8835CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8835CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8846CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8846CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8900CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8900CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8941CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8941CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm6765EsbcyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm6765ESBCYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm8994CyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm8994CYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Frm3468ViicyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"Frm3468VIICYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800EsbccyspcfdCrAggrgtGrp struct {
-	XMLName xml.Name `xml:"ESBCCYSpcfdCrAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Desc LineExplanationType `xml:"desc,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CyotherSpcfdCreditsAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYOtherSpcfdCreditsAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800CyotherSpcfdCrSubTotAggrgtGrp struct {
-	XMLName xml.Name `xml:"CYOtherSpcfdCrSubTotAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800TotCygbcorEsbcamtAggrgtGrp struct {
-	XMLName xml.Name `xml:"TotCYGBCOrESBCAmtAggrgtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// Element
-type Irs3800Tot8844GbcorEsbcaggrgtAmtGrp struct {
-	XMLName xml.Name `xml:"Tot8844GBCOrESBCAggrgtAmtGrp"`
-
-	LineNumberTxt TextType `xml:"lineNumberTxt,attr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// XSD ComplexType declarations
-
-type UsitemizedEntryType struct {
-	XMLName xml.Name
-
-	Desc LineExplanationType `xml:"Desc"`
-
-	Amt UsamountType `xml:"Amt"`
-}
-
-type ForeignItemizedEntryType struct {
-	XMLName xml.Name
-
-	Desc LineExplanationType `xml:"Desc"`
-
-	ForeignAmt ForeignAmountType `xml:"ForeignAmt"`
-}
-
-type UsaddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm CityType `xml:"CityNm"`
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	Zipcd ZipcodeType `xml:"ZIPCd"`
-}
-
-type OtherUsaddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt *StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm *CityType `xml:"CityNm"`
-
-	StateAbbreviationCd *StateType `xml:"StateAbbreviationCd"`
-
-	Zipcd *ZipcodeType `xml:"ZIPCd"`
-}
-
-type ForeignAddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm string `xml:"CityNm"`
-
-	ProvinceOrStateNm string `xml:"ProvinceOrStateNm"`
-
-	CountryCd CountryType `xml:"CountryCd"`
-
-	ForeignPostalCd string `xml:"ForeignPostalCd"`
-}
-
-type OtherForeignAddressType struct {
-	XMLName xml.Name
-
-	AddressLine1Txt *StreetAddressType `xml:"AddressLine1Txt"`
-
-	AddressLine2Txt *StreetAddressType `xml:"AddressLine2Txt"`
-
-	CityNm string `xml:"CityNm"`
-
-	ProvinceOrStateNm string `xml:"ProvinceOrStateNm"`
-
-	CountryCd *CountryType `xml:"CountryCd"`
-
-	ForeignPostalCd string `xml:"ForeignPostalCd"`
-}
-
-type BusinessNameType struct {
-	XMLName xml.Name
-
-	BusinessNameLine1Txt BusinessNameLine1Type `xml:"BusinessNameLine1Txt"`
-
-	BusinessNameLine2Txt *BusinessNameLine2Type `xml:"BusinessNameLine2Txt"`
-}
-
-type NameAndAddressType struct {
-	XMLName xml.Name
-
-	PersonNm *PersonNameType `xml:"PersonNm"`
-
-	BusinessName *BusinessNameType `xml:"BusinessName"`
-
-	Usaddress *UsaddressType `xml:"USAddress"`
-
-	ForeignAddress *ForeignAddressType `xml:"ForeignAddress"`
-}
-
-type PersonFullNameType struct {
-	XMLName xml.Name
-
-	PersonFirstNm PersonFirstNameType `xml:"PersonFirstNm"`
-
-	PersonLastNm *PersonLastNameType `xml:"PersonLastNm"`
-}
-
-type IpaddressType struct {
-	XMLName xml.Name
-
-	Ipv4AddressTxt *Ipv4Type `xml:"IPv4AddressTxt"`
-
-	Ipv6AddressTxt *Ipv6Type `xml:"IPv6AddressTxt"`
-}
-
-type VehicleDescriptionGrpType struct {
-	XMLName xml.Name
-
-	VehicleModelYr YearType `xml:"VehicleModelYr"`
-
-	VehicleMakeNameTxt ShortDescriptionType `xml:"VehicleMakeNameTxt"`
-
-	VehicleModelNameTxt ShortDescriptionType `xml:"VehicleModelNameTxt"`
-}
-
-type ForeignEntityIdentificationGrpType struct {
-	XMLName xml.Name
-
-	ForeignEntityReferenceIdNum string `xml:",any"`
-}
-
-type Form1099StateLocalTaxType struct {
-	XMLName xml.Name
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	StateIdNum string `xml:"StateIdNum"`
-
-	StateTaxWithheldAmt UsamountNntype `xml:"StateTaxWithheldAmt"`
-
-	LocalAbbreviationCdTxt string `xml:"LocalAbbreviationCdTxt"`
-
-	LocalityNm *ShortDescriptionType `xml:"LocalityNm"`
-
-	LocalTaxWithheldAmt *UsamountNntype `xml:"LocalTaxWithheldAmt"`
-}
-
-type Form1099OtherStateLocalTaxType struct {
-	XMLName xml.Name
-
-	StateTaxWithheldAmt UsamountNntype `xml:"StateTaxWithheldAmt"`
-
-	StateAbbreviationCd StateType `xml:"StateAbbreviationCd"`
-
-	StateIdNum string `xml:"StateIdNum"`
-
-	StateIncomeAmt *UsamountNntype `xml:"StateIncomeAmt"`
-
-	LocalTaxWithheldAmt *UsamountNntype `xml:"LocalTaxWithheldAmt"`
-
-	LocalityNm *ShortDescriptionType `xml:"LocalityNm"`
-
-	LocalIncomeAmt *UsamountNntype `xml:"LocalIncomeAmt"`
-}
-
-type Irs3800Type struct {
-	XMLName xml.Name
-
-	CamtandBeatind *BooleanType `xml:"CAMTAndBEATInd"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PssvActyForGenBusCrAllowedAmt *UsamountNntype `xml:"PssvActyForGenBusCrAllowedAmt"`
-
-	CygeneralBusCrCarryforwardAmt *Irs3800CygeneralBusCrCarryforwardAmt `xml:"CYGeneralBusCrCarryforwardAmt"`
-
-	CarryBackGeneralBusinessCrAmt *UsamountType `xml:"CarryBackGeneralBusinessCrAmt"`
-
-	CycreditsNotAllwAgainstTmtamt *UsamountNntype `xml:"CYCreditsNotAllwAgainstTMTAmt"`
-
-	RegularTaxBeforeCreditsAmt *UsamountType `xml:"RegularTaxBeforeCreditsAmt"`
-
-	AlternativeMinimumTaxAmt *UsamountType `xml:"AlternativeMinimumTaxAmt"`
-
-	AdjustedRegTaxBeforeCreditAmt *UsamountType `xml:"AdjustedRegTaxBeforeCreditAmt"`
-
-	ForeignTaxCreditAmt *UsamountType `xml:"ForeignTaxCreditAmt"`
-
-	CertainAllowableCreditsAmt *UsamountType `xml:"CertainAllowableCreditsAmt"`
-
-	TotalTaxCreditsAmt *UsamountType `xml:"TotalTaxCreditsAmt"`
-
-	NetIncomeTaxAmt *UsamountNntype `xml:"NetIncomeTaxAmt"`
-
-	NetRegularTaxAmt *UsamountNntype `xml:"NetRegularTaxAmt"`
-
-	ExcessNetRegularTaxAmt *UsamountType `xml:"ExcessNetRegularTaxAmt"`
-
-	TentativeMinimumTaxAmt *UsamountType `xml:"TentativeMinimumTaxAmt"`
-
-	AdjustedExcessNetRegularTaxAmt *UsamountType `xml:"AdjustedExcessNetRegularTaxAmt"`
-
-	AdjustedNetIncomeTaxAmt *UsamountNntype `xml:"AdjustedNetIncomeTaxAmt"`
-
-	SmllrCynotAllwTmtorTotAdjAmt *Irs3800SmllrCynotAllwTmtorTotAdjAmt `xml:"SmllrCYNotAllwTMTOrTotAdjAmt"`
-
-	TentativeMinimunTaxTimesPctAmt *UsamountType `xml:"TentativeMinimunTaxTimesPctAmt"`
-
-	GreaterExcessOrTimesPctAmt *UsamountType `xml:"GreaterExcessOrTimesPctAmt"`
-
-	NetIncmTaxLessGreaterExcessAmt *UsamountNntype `xml:"NetIncmTaxLessGreaterExcessAmt"`
-
-	SubSmllrFromNetLessGreaterAmt *UsamountNntype `xml:"SubSmllrFromNetLessGreaterAmt"`
-
-	TotEmpwrZoneGenBusCreditsAmt *UsamountNntype `xml:"TotEmpwrZoneGenBusCreditsAmt"`
-
-	GbcfromPssvActyAllPartsAmt *UsamountNntype `xml:"GBCFromPssvActyAllPartsAmt"`
-
-	PassiveActyAllowedForTyamt *UsamountNntype `xml:"PassiveActyAllowedForTYAmt"`
-
-	TotalPassiveActivityCreditAmt *UsamountType `xml:"TotalPassiveActivityCreditAmt"`
-
-	EmpwrZoneAndComEmploymentCrAmt *UsamountType `xml:"EmpwrZoneAndComEmploymentCrAmt"`
-
-	NetIncomeTaxLessPctExcessAmt *UsamountNntype `xml:"NetIncomeTaxLessPctExcessAmt"`
-
-	SumSmllrEmpwrZnEmplmnCrAmt *UsamountType `xml:"SumSmllrEmpwrZnEmplmnCrAmt"`
-
-	NetSmllrAndEmpwrZnEmplmnCrAmt *UsamountNntype `xml:"NetSmllrAndEmpwrZnEmplmnCrAmt"`
-
-	AllwGenBusCrFromNonPssvActyAmt *UsamountNntype `xml:"AllwGenBusCrFromNonPssvActyAmt"`
-
-	GenBusEligSmllBusPssvActyCrAmt *UsamountNntype `xml:"GenBusEligSmllBusPssvActyCrAmt"`
-
-	OtherSpecifiedAllwGenBusCrAmt *UsamountNntype `xml:"OtherSpecifiedAllwGenBusCrAmt"`
-
-	AllwGenAndEligSmllBusCfwdCrAmt *Irs3800AllwGenAndEligSmllBusCfwdCrAmt `xml:"AllwGenAndEligSmllBusCfwdCrAmt"`
-
-	AllwGenAndEligSmllBusCybkCrAmt *UsamountNntype `xml:"AllwGenAndEligSmllBusCybkCrAmt"`
-
-	TotAllwGenAndEligSmllBusCrAmt *UsamountNntype `xml:"TotAllwGenAndEligSmllBusCrAmt"`
-
-	SmllrGenBusCrOrTotGenEligCrAmt *UsamountNntype `xml:"SmllrGenBusCrOrTotGenEligCrAmt"`
-
-	CurrentYearCreditAllowedAmt *Irs3800CurrentYearCreditAllowedAmt `xml:"CurrentYearCreditAllowedAmt"`
-
-	Form3468PartIicycreditsGrp *Irs3800Form3468PartIicycreditsGrp `xml:"Form3468PartIICYCreditsGrp"`
-
-	Form7207CycreditsGrp *Irs3800Form7207CycreditsGrp `xml:"Form7207CYCreditsGrp"`
-
-	Form6765CycreditsGrp *Irs3800Form6765CycreditsGrp `xml:"Form6765CYCreditsGrp"`
-
-	Form3468PartIiicycreditsGrp *Irs3800Form3468PartIiicycreditsGrp `xml:"Form3468PartIIICYCreditsGrp"`
-
-	Form8826CycreditsGrp *Irs3800Form8826CycreditsGrp `xml:"Form8826CYCreditsGrp"`
-
-	Form8835PartIicycreditsGrp *Irs3800Form8835PartIicycreditsGrp `xml:"Form8835PartIICYCreditsGrp"`
-
-	Form7210CycreditsGrp *Irs3800Form7210CycreditsGrp `xml:"Form7210CYCreditsGrp"`
-
-	Form8820CycreditsGrp *Irs3800Form8820CycreditsGrp `xml:"Form8820CYCreditsGrp"`
-
-	Form8874CycreditsGrp *Irs3800Form8874CycreditsGrp `xml:"Form8874CYCreditsGrp"`
-
-	Form8881PartIcycreditsGrp *Irs3800Form8881PartIcycreditsGrp `xml:"Form8881PartICYCreditsGrp"`
-
-	Form8882CycreditsGrp *Irs3800Form8882CycreditsGrp `xml:"Form8882CYCreditsGrp"`
-
-	Form8864CycreditsGrp *Irs3800Form8864CycreditsGrp `xml:"Form8864CYCreditsGrp"`
-
-	Form8896CycreditsGrp *Irs3800Form8896CycreditsGrp `xml:"Form8896CYCreditsGrp"`
-
-	Form8906CycreditsGrp *Irs3800Form8906CycreditsGrp `xml:"Form8906CYCreditsGrp"`
-
-	Form3468PartIvcycreditsGrp *Cygbcpssv2GrpType `xml:"Form3468PartIVCYCreditsGrp"`
-
-	Form8908CycreditsGrp *Irs3800Form8908CycreditsGrp `xml:"Form8908CYCreditsGrp"`
-
-	Form7218PartIicycreditsGrp *Irs3800Form7218PartIicycreditsGrp `xml:"Form7218PartIICYCreditsGrp"`
-
-	Form8911PartIcycreditsGrp *Irs3800Form8911PartIcycreditsGrp `xml:"Form8911PartICYCreditsGrp"`
-
-	Form8830CycreditsGrp *Irs3800Form8830CycreditsGrp `xml:"Form8830CYCreditsGrp"`
-
-	Form7213PartIicycreditsGrp *Irs3800Form7213PartIicycreditsGrp `xml:"Form7213PartIICYCreditsGrp"`
-
-	Form3468PartVcycreditsGrp *Irs3800Form3468PartVcycreditsGrp `xml:"Form3468PartVCYCreditsGrp"`
-
-	Form8932CycreditsGrp *Irs3800Form8932CycreditsGrp `xml:"Form8932CYCreditsGrp"`
-
-	Form8933CycreditsGrp *Irs3800Form8933CycreditsGrp `xml:"Form8933CYCreditsGrp"`
-
-	Form8936PartIicycreditsGrp *Irs3800Form8936PartIicycreditsGrp `xml:"Form8936PartIICYCreditsGrp"`
-
-	Form8936PartVcycreditsGrp *Irs3800Form8936PartVcycreditsGrp `xml:"Form8936PartVCYCreditsGrp"`
-
-	Form8904CycreditsGrp *Irs3800Form8904CycreditsGrp `xml:"Form8904CYCreditsGrp"`
-
-	Form7213PartIcycreditsGrp *Irs3800Form7213PartIcycreditsGrp `xml:"Form7213PartICYCreditsGrp"`
-
-	Form8881PartIicycreditsGrp *Irs3800Form8881PartIicycreditsGrp `xml:"Form8881PartIICYCreditsGrp"`
-
-	Form8881PartIiicycreditsGrp *Irs3800Form8881PartIiicycreditsGrp `xml:"Form8881PartIIICYCreditsGrp"`
-
-	Frm8864SafcycreditsGrp *Irs3800Frm8864SafcycreditsGrp `xml:"Frm8864SAFCYCreditsGrp"`
-
-	Form7211PartIicycreditsGrp *Irs3800Form7211PartIicycreditsGrp `xml:"Form7211PartIICYCreditsGrp"`
-
-	GenBusCyotherCreditsGrp *Irs3800GenBusCyotherCreditsGrp `xml:"GenBusCYOtherCreditsGrp"`
-
-	GenBusCycreditsSubTotGrp *TotGbcycrGrpType `xml:"GenBusCYCreditsSubTotGrp"`
-
-	Form8844CycreditsGrp *Irs3800Form8844CycreditsGrp `xml:"Form8844CYCreditsGrp"`
-
-	Form3468PartVicycreditsGrp *Irs3800Form3468PartVicycreditsGrp `xml:"Form3468PartVICYCreditsGrp"`
-
-	Form5884CycreditsGrp *Irs3800Form5884CycreditsGrp `xml:"Form5884CYCreditsGrp"`
-
-	Form6478CycreditsGrp *Irs3800Form6478CycreditsGrp `xml:"Form6478CYCreditsGrp"`
-
-	Form8586CycreditsGrp *Irs3800Form8586CycreditsGrp `xml:"Form8586CYCreditsGrp"`
-
-	Frm8835PartIicyspcfdCreditsGrp *Irs3800Frm8835PartIicyspcfdCreditsGrp `xml:"Frm8835PartIICYSpcfdCreditsGrp"`
-
-	Form8846CycreditsGrp *Irs3800Form8846CycreditsGrp `xml:"Form8846CYCreditsGrp"`
-
-	Form8900CycreditsGrp *Irs3800Form8900CycreditsGrp `xml:"Form8900CYCreditsGrp"`
-
-	Form8941CycreditsGrp *Irs3800Form8941CycreditsGrp `xml:"Form8941CYCreditsGrp"`
-
-	Form6765EsbcycreditsGrp *Irs3800Form6765EsbcycreditsGrp `xml:"Form6765ESBCYCreditsGrp"`
-
-	Form8994CycreditsGrp *Irs3800Form8994CycreditsGrp `xml:"Form8994CYCreditsGrp"`
-
-	Form3468PartViicycreditsGrp *Irs3800Form3468PartViicycreditsGrp `xml:"Form3468PartVIICYCreditsGrp"`
-
-	GenBusOtherSpecifiedCycrGrp *CygbcpssvGrpType `xml:"GenBusOtherSpecifiedCYCrGrp"`
-
-	GenBusCycreditsSubTot2Grp *TotGbcycrGrpType `xml:"GenBusCYCreditsSubTot2Grp"`
-
-	TotGenBusCycreditAmtGrp *TotGbcycrGrpType `xml:"TotGenBusCYCreditAmtGrp"`
-
-	Frm3468PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIICYCyovCrGrp"`
-
-	Frm7207CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7207CYCyovCrGrp"`
-
-	Frm6765CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm6765CYCyovCrGrp"`
-
-	Frm3468PartIiicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIIICYCyovCrGrp"`
-
-	Frm8826CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8826CYCyovCrGrp"`
-
-	Frm8835PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8835PartIICYCyovCrGrp"`
-
-	Frm7210CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7210CYCyovCrGrp"`
-
-	Frm8820CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8820CYCyovCrGrp"`
-
-	Frm8874CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8874CYCyovCrGrp"`
-
-	Frm8881PartIcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartICYCyovCrGrp"`
-
-	Frm8882CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8882CYCyovCrGrp"`
-
-	Frm8864BiodieselCycreditsGrp *CyovCygenBusCrGrpType `xml:"Frm8864BiodieselCYCreditsGrp"`
-
-	Frm8896CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8896CYCyovCrGrp"`
-
-	Frm8906CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8906CYCyovCrGrp"`
-
-	Frm3468PartIvcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartIVCYCyovCrGrp"`
-
-	Frm8908CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8908CYCyovCrGrp"`
-
-	Frm8911CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8911CYCyovCrGrp"`
-
-	Frm8830CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8830CYCyovCrGrp"`
-
-	Frm7213PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7213PartIICYCyovCrGrp"`
-
-	Frm3468PartVcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVCYCyovCrGrp"`
-
-	Frm8932CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8932CYCyovCrGrp"`
-
-	Frm8933CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8933CYCyovCrGrp"`
-
-	Frm8936PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8936PartIICYCyovCrGrp"`
-
-	Frm8936PartVcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8936PartVCYCyovCrGrp"`
-
-	Frm8904CycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8904CYCyovCrGrp"`
-
-	Frm7213PartIcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm7213PartICYCyovCrGrp"`
-
-	Frm8881PartIicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartIICYCyovCrGrp"`
-
-	Frm8881PartIiicycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8881PartIIICYCyovCrGrp"`
-
-	Frm8864SafcycyovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8864SAFCYCyovCrGrp"`
-
-	CycyovOtherBusCreditsGrp *CyovCygenBusCrGrpType `xml:"CYCyovOtherBusCreditsGrp"`
-
-	Frm5884AcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884ACYCfwdAllwCrGrp"`
-
-	Frm8586CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8586CYCfwdAllwCrGrp"`
-
-	Frm8845CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8845CYCfwdAllwCrGrp"`
-
-	Frm8907CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8907CYCfwdAllwCrGrp"`
-
-	Frm8909CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8909CYCfwdAllwCrGrp"`
-
-	Frm8923CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8923CYCfwdAllwCrGrp"`
-
-	Frm8834PartIcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8834PartICYCfwdAllwCrGrp"`
-
-	Frm8931CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8931CYCfwdAllwCrGrp"`
-
-	Frm1065BcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm1065BCYCfwdAllwCrGrp"`
-
-	Frm5884CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884CYCfwdAllwCrGrp"`
-
-	Frm6478CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm6478CYCfwdAllwCrGrp"`
-
-	Frm8846CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8846CYCfwdAllwCrGrp"`
-
-	Frm8900CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8900CYCfwdAllwCrGrp"`
-
-	CycfwdAllwCrTransAkpipelineGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrTransAKPipelineGrp"`
-
-	CycfwdAllwCrEmplrAffctHrrcnGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrEmplrAffctHrrcnGrp"`
-
-	CycfwdAllwCrHrrcnKtrnHsngGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrHrrcnKtrnHsngGrp"`
-
-	CycfwdAllwCrMwdDsstrEmplrGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrMwdDsstrEmplrGrp"`
-
-	CycfwdAllwCrEmplrHsngGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwCrEmplrHsngGrp"`
-
-	Frm5884BcycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884BCYCfwdAllwCrGrp"`
-
-	Frm8847CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8847CYCfwdAllwCrGrp"`
-
-	Frm8861CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8861CYCfwdAllwCrGrp"`
-
-	Frm8884CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8884CYCfwdAllwCrGrp"`
-
-	Frm8942CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8942CYCfwdAllwCrGrp"`
-
-	Frm8910CycfwdAllwCrGrp *CyovCygenBusCrGrpType `xml:"Frm8910CYCfwdAllwCrGrp"`
-
-	CycfwdAllwOtherBusCreditsGrp *CyovCygenBusCrGrpType `xml:"CYCfwdAllwOtherBusCreditsGrp"`
-
-	Frm8844CycrovCrGrp *CyovCygenBusCrGrpType `xml:"Frm8844CYCrovCrGrp"`
-
-	Frm3468PartVicyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVICYSpcfdCrGrp"`
-
-	Frm5884CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm5884CYSpcfdCrGrp"`
-
-	Frm6478CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm6478CYSpcfdCrGrp"`
-
-	Frm8586CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8586CYSpcfdCrGrp"`
-
-	Frm8835CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8835CYSpcfdCrGrp"`
-
-	Frm8846CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8846CYSpcfdCrGrp"`
-
-	Frm8900CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8900CYSpcfdCrGrp"`
-
-	Frm8941CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8941CYSpcfdCrGrp"`
-
-	Frm6765EsbcyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm6765ESBCYSpcfdCrGrp"`
-
-	Frm8994CyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm8994CYSpcfdCrGrp"`
-
-	Frm3468PartViicyspcfdCrGrp *CyovCygenBusCrGrpType `xml:"Frm3468PartVIICYSpcfdCrGrp"`
-
-	EscbcycreditsGrp *CyovCygenBusCrGrpType `xml:"ESCBCYCreditsGrp"`
-
-	CyotherSpcfdCreditsGrp *CyovCygenBusCrGrpType `xml:"CYOtherSpcfdCreditsGrp"`
-
-	CyotherSpcfdCreditsSubTotGrp *TotalCyovCybusCreditType `xml:"CYOtherSpcfdCreditsSubTotGrp"`
-
-	TotCygbcorEsbcamtGrp *TotalCyovCybusCreditType `xml:"TotCYGBCOrESBCAmtGrp"`
-
-	Tot8844OthSpcfdGbcorEsbcamtGrp *TotalCyovCybusCreditType `xml:"Tot8844OthSpcfdGBCOrESBCAmtGrp"`
-
-	GbcbreakdownCyaggrgtAmtGrp *Irs3800GbcbreakdownCyaggrgtAmtGrp `xml:"GBCBreakdownCYAggrgtAmtGrp"`
-
-	Frm3468PartIicycyovCrAggrgtGrp *Irs3800Frm3468PartIicycyovCrAggrgtGrp `xml:"Frm3468PartIICYCyovCrAggrgtGrp"`
-
-	Frm7207CycyovCrAggrgtGrp *Irs3800Frm7207CycyovCrAggrgtGrp `xml:"Frm7207CYCyovCrAggrgtGrp"`
-
-	Frm6765CycyovCrAggrgtGrp *Irs3800Frm6765CycyovCrAggrgtGrp `xml:"Frm6765CYCyovCrAggrgtGrp"`
-
-	Frm3468PartIiicyovCrAggrgtGrp *Irs3800Frm3468PartIiicyovCrAggrgtGrp `xml:"Frm3468PartIIICyovCrAggrgtGrp"`
-
-	Frm8826CycyovCrAggrgtGrp *Irs3800Frm8826CycyovCrAggrgtGrp `xml:"Frm8826CYCyovCrAggrgtGrp"`
-
-	Frm8835PartIicycyovCrAggrgtGrp *Irs3800Frm8835PartIicycyovCrAggrgtGrp `xml:"Frm8835PartIICYCyovCrAggrgtGrp"`
-
-	Frm7210CycyovCrAggrgtGrp *Irs3800Frm7210CycyovCrAggrgtGrp `xml:"Frm7210CYCyovCrAggrgtGrp"`
-
-	Frm8820CycyovCrAggrgtGrp *Irs3800Frm8820CycyovCrAggrgtGrp `xml:"Frm8820CYCyovCrAggrgtGrp"`
-
-	Frm8874CycyovCrAggrgtGrp *Irs3800Frm8874CycyovCrAggrgtGrp `xml:"Frm8874CYCyovCrAggrgtGrp"`
-
-	Frm8881PartIcycyovCrAggrgtGrp *Irs3800Frm8881PartIcycyovCrAggrgtGrp `xml:"Frm8881PartICYCyovCrAggrgtGrp"`
-
-	Frm8882CycyovCrAggrgtGrp *Irs3800Frm8882CycyovCrAggrgtGrp `xml:"Frm8882CYCyovCrAggrgtGrp"`
-
-	Frm8864BdslCycyovCrAggrgtGrp *Irs3800Frm8864BdslCycyovCrAggrgtGrp `xml:"Frm8864BdslCYCyovCrAggrgtGrp"`
-
-	Frm8896CycyovCrAggrgtGrp *Irs3800Frm8896CycyovCrAggrgtGrp `xml:"Frm8896CYCyovCrAggrgtGrp"`
-
-	Frm8906CycyovCrAggrgtGrp *Irs3800Frm8906CycyovCrAggrgtGrp `xml:"Frm8906CYCyovCrAggrgtGrp"`
-
-	Frm3468PartIvcycyovCrAggrgtGrp *Irs3800Frm3468PartIvcycyovCrAggrgtGrp `xml:"Frm3468PartIVCYCyovCrAggrgtGrp"`
-
-	Frm8908CycyovCrAggrgtGrp *Irs3800Frm8908CycyovCrAggrgtGrp `xml:"Frm8908CYCyovCrAggrgtGrp"`
-
-	Frm8911CycyovCrAggrgtGrp *Irs3800Frm8911CycyovCrAggrgtGrp `xml:"Frm8911CYCyovCrAggrgtGrp"`
-
-	Frm8830CycyovCrAggrgtGrp *Irs3800Frm8830CycyovCrAggrgtGrp `xml:"Frm8830CYCyovCrAggrgtGrp"`
-
-	Frm7213PartIicycyovCrAggrgtGrp *Irs3800Frm7213PartIicycyovCrAggrgtGrp `xml:"Frm7213PartIICYCyovCrAggrgtGrp"`
-
-	Frm3468PartVcycyovCrAggrgtGrp *Irs3800Frm3468PartVcycyovCrAggrgtGrp `xml:"Frm3468PartVCYCyovCrAggrgtGrp"`
-
-	Frm8932CycyovCrAggrgtGrp *Irs3800Frm8932CycyovCrAggrgtGrp `xml:"Frm8932CYCyovCrAggrgtGrp"`
-
-	Frm8933CycyovCrAggrgtGrp *Irs3800Frm8933CycyovCrAggrgtGrp `xml:"Frm8933CYCyovCrAggrgtGrp"`
-
-	Frm8936PartIicycyovCrAggrgtGrp *Irs3800Frm8936PartIicycyovCrAggrgtGrp `xml:"Frm8936PartIICYCyovCrAggrgtGrp"`
-
-	Frm8936PartVcycyovCrAggrgtGrp *Irs3800Frm8936PartVcycyovCrAggrgtGrp `xml:"Frm8936PartVCYCyovCrAggrgtGrp"`
-
-	Frm8904CycyovCrAggrgtGrp *Irs3800Frm8904CycyovCrAggrgtGrp `xml:"Frm8904CYCyovCrAggrgtGrp"`
-
-	Frm7213PartIcycyovCrAggrgtGrp *Irs3800Frm7213PartIcycyovCrAggrgtGrp `xml:"Frm7213PartICYCyovCrAggrgtGrp"`
-
-	Frm8881PartIicycyovCrAggrgtGrp *Irs3800Frm8881PartIicycyovCrAggrgtGrp `xml:"Frm8881PartIICYCyovCrAggrgtGrp"`
-
-	Frm8881PartIiicycyovCrAggrgGrp *Irs3800Frm8881PartIiicycyovCrAggrgGrp `xml:"Frm8881PartIIICYCyovCrAggrgGrp"`
-
-	Frm8864SafcycyovCrAggrgtGrp *Irs3800Frm8864SafcycyovCrAggrgtGrp `xml:"Frm8864SAFCYCyovCrAggrgtGrp"`
-
-	CycyovOtherBusCreditsAggrgtGrp *Irs3800CycyovOtherBusCreditsAggrgtGrp `xml:"CYCyovOtherBusCreditsAggrgtGrp"`
-
-	Frm5884AcycfwdAllwCrAggrgtGrp *Irs3800Frm5884AcycfwdAllwCrAggrgtGrp `xml:"Frm5884ACYCfwdAllwCrAggrgtGrp"`
-
-	Frm8586CycfwdAllwCrAggrgtGrp *Irs3800Frm8586CycfwdAllwCrAggrgtGrp `xml:"Frm8586CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8845CycfwdAllwCrAggrgtGrp *Irs3800Frm8845CycfwdAllwCrAggrgtGrp `xml:"Frm8845CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8907CycfwdAllwCrAggrgtGrp *Irs3800Frm8907CycfwdAllwCrAggrgtGrp `xml:"Frm8907CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8909CycfwdAllwCrAggrgtGrp *Irs3800Frm8909CycfwdAllwCrAggrgtGrp `xml:"Frm8909CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8923CycfwdAllwCrAggrgtGrp *Irs3800Frm8923CycfwdAllwCrAggrgtGrp `xml:"Frm8923CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8834CycfwdCrAggrgtGrp *Irs3800Frm8834CycfwdCrAggrgtGrp `xml:"Frm8834CYCfwdCrAggrgtGrp"`
-
-	Frm8931CycfwdAllwCrAggrgtGrp *Irs3800Frm8931CycfwdAllwCrAggrgtGrp `xml:"Frm8931CYCfwdAllwCrAggrgtGrp"`
-
-	Frm1065BcycfwdAllwCrAggrgtGrp *Irs3800Frm1065BcycfwdAllwCrAggrgtGrp `xml:"Frm1065BCYCfwdAllwCrAggrgtGrp"`
-
-	Frm5884CycfwdAllwCrAggrgtGrp *Irs3800Frm5884CycfwdAllwCrAggrgtGrp `xml:"Frm5884CYCfwdAllwCrAggrgtGrp"`
-
-	Frm6478CycfwdAllwCrAggrgtGrp *Irs3800Frm6478CycfwdAllwCrAggrgtGrp `xml:"Frm6478CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8846CycfwdAllwCrAggrgtGrp *Irs3800Frm8846CycfwdAllwCrAggrgtGrp `xml:"Frm8846CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8900CycfwdAllwCrAggrgtGrp *Irs3800Frm8900CycfwdAllwCrAggrgtGrp `xml:"Frm8900CYCfwdAllwCrAggrgtGrp"`
-
-	CycfwdAllwTrAkpplnAggrgtGrp *Irs3800CycfwdAllwTrAkpplnAggrgtGrp `xml:"CYCfwdAllwTrAKPplnAggrgtGrp"`
-
-	CycfwdEmplrAffctHrrcnAggrgtGrp *Irs3800CycfwdEmplrAffctHrrcnAggrgtGrp `xml:"CYCfwdEmplrAffctHrrcnAggrgtGrp"`
-
-	CycfwdAllwKtrnHsngAggrgtGrp *Irs3800CycfwdAllwKtrnHsngAggrgtGrp `xml:"CYCfwdAllwKtrnHsngAggrgtGrp"`
-
-	CycfwdCrMwdDsstrEmplrAggrgtGrp *Irs3800CycfwdCrMwdDsstrEmplrAggrgtGrp `xml:"CYCfwdCrMwdDsstrEmplrAggrgtGrp"`
-
-	CycfwdAllwCrEmplrHsngAggrgtGrp *Irs3800CycfwdAllwCrEmplrHsngAggrgtGrp `xml:"CYCfwdAllwCrEmplrHsngAggrgtGrp"`
-
-	Frm5884BcycfwdAllwCrAggrgtGrp *Irs3800Frm5884BcycfwdAllwCrAggrgtGrp `xml:"Frm5884BCYCfwdAllwCrAggrgtGrp"`
-
-	Frm8847CycfwdAllwCrAggrgtGrp *Irs3800Frm8847CycfwdAllwCrAggrgtGrp `xml:"Frm8847CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8861CycfwdAllwCrAggrgtGrp *Irs3800Frm8861CycfwdAllwCrAggrgtGrp `xml:"Frm8861CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8884CycfwdAllwCrAggrgtGrp *Irs3800Frm8884CycfwdAllwCrAggrgtGrp `xml:"Frm8884CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8942CycfwdAllwCrAggrgtGrp *Irs3800Frm8942CycfwdAllwCrAggrgtGrp `xml:"Frm8942CYCfwdAllwCrAggrgtGrp"`
-
-	Frm8910CycfwdAllwCrAggrgtGrp *Irs3800Frm8910CycfwdAllwCrAggrgtGrp `xml:"Frm8910CYCfwdAllwCrAggrgtGrp"`
-
-	CycfwdAllwOtherBusCrAggrgtGrp *Irs3800CycfwdAllwOtherBusCrAggrgtGrp `xml:"CYCfwdAllwOtherBusCrAggrgtGrp"`
-
-	Frm8844CycyovCrAggrgtGrp *Irs3800Frm8844CycyovCrAggrgtGrp `xml:"Frm8844CYCyovCrAggrgtGrp"`
-
-	Frm3468PartVicyspfdCrAggrgtGrp *Irs3800Frm3468PartVicyspfdCrAggrgtGrp `xml:"Frm3468PartVICYSpfdCrAggrgtGrp"`
-
-	Frm5884CyspcfdCrAggrgtGrp *Irs3800Frm5884CyspcfdCrAggrgtGrp `xml:"Frm5884CYSpcfdCrAggrgtGrp"`
-
-	Frm6478CyspcfdCrAggrgtGrp *Irs3800Frm6478CyspcfdCrAggrgtGrp `xml:"Frm6478CYSpcfdCrAggrgtGrp"`
-
-	Frm8586CyspcfdCrAggrgtGrp *Irs3800Frm8586CyspcfdCrAggrgtGrp `xml:"Frm8586CYSpcfdCrAggrgtGrp"`
-
-	Frm8835CyspcfdCrAggrgtGrp *Irs3800Frm8835CyspcfdCrAggrgtGrp `xml:"Frm8835CYSpcfdCrAggrgtGrp"`
-
-	Frm8846CyspcfdCrAggrgtGrp *Irs3800Frm8846CyspcfdCrAggrgtGrp `xml:"Frm8846CYSpcfdCrAggrgtGrp"`
-
-	Frm8900CyspcfdCrAggrgtGrp *Irs3800Frm8900CyspcfdCrAggrgtGrp `xml:"Frm8900CYSpcfdCrAggrgtGrp"`
-
-	Frm8941CyspcfdCrAggrgtGrp *Irs3800Frm8941CyspcfdCrAggrgtGrp `xml:"Frm8941CYSpcfdCrAggrgtGrp"`
-
-	Frm6765EsbcyspcfdCrAggrgtGrp *Irs3800Frm6765EsbcyspcfdCrAggrgtGrp `xml:"Frm6765ESBCYSpcfdCrAggrgtGrp"`
-
-	Frm8994CyspcfdCrAggrgtGrp *Irs3800Frm8994CyspcfdCrAggrgtGrp `xml:"Frm8994CYSpcfdCrAggrgtGrp"`
-
-	Frm3468ViicyspcfdCrAggrgtGrp *Irs3800Frm3468ViicyspcfdCrAggrgtGrp `xml:"Frm3468VIICYSpcfdCrAggrgtGrp"`
-
-	EsbccyspcfdCrAggrgtGrp *Irs3800EsbccyspcfdCrAggrgtGrp `xml:"ESBCCYSpcfdCrAggrgtGrp"`
-
-	CyotherSpcfdCreditsAggrgtGrp *Irs3800CyotherSpcfdCreditsAggrgtGrp `xml:"CYOtherSpcfdCreditsAggrgtGrp"`
-
-	CyotherSpcfdCrSubTotAggrgtGrp *Irs3800CyotherSpcfdCrSubTotAggrgtGrp `xml:"CYOtherSpcfdCrSubTotAggrgtGrp"`
-
-	TotCygbcorEsbcamtAggrgtGrp *Irs3800TotCygbcorEsbcamtAggrgtGrp `xml:"TotCYGBCOrESBCAmtAggrgtGrp"`
-
-	Tot8844GbcorEsbcaggrgtAmtGrp *Irs3800Tot8844GbcorEsbcaggrgtAmtGrp `xml:"Tot8844GBCOrESBCAggrgtAmtGrp"`
-}
-
-type CygbcpssvGrpType struct {
-	XMLName xml.Name
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type Cygbcpssv2GrpType struct {
-	XMLName xml.Name
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type ElctvPymtPssvGrpType struct {
-	XMLName xml.Name
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type TotGbcycrGrpType struct {
-	XMLName xml.Name
-
-	CygeneralBusinessCrItemCnt string `xml:"CYGeneralBusinessCrItemCnt"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	CreditTransferElectionAmt *UsamountType `xml:"CreditTransferElectionAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-}
-
-type TotalCyovCybusCreditType struct {
-	XMLName xml.Name
-
-	CyovGeneralBusinessCrItemCnt string `xml:"CyovGeneralBusinessCrItemCnt"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type CygbcaggrgtAmtGrpType struct {
-	XMLName xml.Name
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type ElctvPymtAggrgtAmtGrpType struct {
-	XMLName xml.Name
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type ElctvPymtAggrgtAmt2GrpType struct {
-	XMLName xml.Name
-
-	TransferCreditEntityEin *Eintype `xml:"TransferCreditEntityEIN"`
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	ElectivePaymentRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"ElectivePaymentRegistrationNum"`
-
-	TransferRegistrationNum *FacilityIrsissdRegistrationNumType `xml:"TransferRegistrationNum"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type TotGbccrAggrgtAmtGrpType struct {
-	XMLName xml.Name
-
-	OthThnCrTrnsfrElectCrBfrLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrBfrLmtAmt"`
-
-	CrTrnsfrElectCrSoldBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrSoldBfrLmtAmt"`
-
-	CrTrnsfrElectCrPrchsBfrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrPrchsBfrLmtAmt"`
-
-	CrTrnsfrElectCrAllwAftrLmtAmt *UsamountType `xml:"CrTrnsfrElectCrAllwAftrLmtAmt"`
-
-	OthThnCrTrnsfrElectCrNoLmtAmt *UsamountType `xml:"OthThnCrTrnsfrElectCrNoLmtAmt"`
-
-	TrnsfrElectCrSoldNoLmtAmt *UsamountType `xml:"TrnsfrElectCrSoldNoLmtAmt"`
-
-	PrchsTrnsfrElectCrNoLmtAmt *UsamountType `xml:"PrchsTrnsfrElectCrNoLmtAmt"`
-
-	TotalGeneralBusCreditsAmt *UsamountType `xml:"TotalGeneralBusCreditsAmt"`
-
-	GrossElectivePymtElectionAmt *UsamountType `xml:"GrossElectivePymtElectionAmt"`
-
-	TotalGbclessGrossEpeamt *UsamountType `xml:"TotalGBCLessGrossEPEAmt"`
-
-	TotalGbclessGrossEpeappTxAmt *UsamountType `xml:"TotalGBCLessGrossEPEAppTxAmt"`
-
-	EpeeligibleCrAppTxAmt *UsamountType `xml:"EPEEligibleCrAppTxAmt"`
-
-	NetElectivePymtElectionAmt *UsamountType `xml:"NetElectivePymtElectionAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-}
-
-type CyovGbcaggrgtAmtGrpType struct {
-	XMLName xml.Name
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type CyovCygenBusCrGrpType struct {
-	XMLName xml.Name
-
-	CyovGeneralBusinessCrItemCnt string `xml:"CyovGeneralBusinessCrItemCnt"`
-
-	Yr *YearType `xml:"Yr"`
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-type TotCyovCybusCrGrpAggrgtType struct {
-	XMLName xml.Name
-
-	CrSubjToPassiveActyLmtAmt *UsamountType `xml:"CrSubjToPassiveActyLmtAmt"`
-
-	PassiveActivityCrAfterLmtAmt *UsamountType `xml:"PassiveActivityCrAfterLmtAmt"`
-
-	GeneralBusCrFromNnPssvActyAmt *UsamountType `xml:"GeneralBusCrFromNnPssvActyAmt"`
-
-	TotalGeneralBusCreditsAppTxAmt *UsamountType `xml:"TotalGeneralBusCreditsAppTxAmt"`
-
-	GeneralBusCrCyovRcptrAdjAmt *UsamountType `xml:"GeneralBusCrCyovRcptrAdjAmt"`
-
-	CarryforwardGeneralBusCrAmt *UsamountType `xml:"CarryforwardGeneralBusCrAmt"`
-
-	PassThroughEntityEin *Eintype `xml:"PassThroughEntityEIN"`
-
-	MissingEinreasonCd string `xml:"MissingEINReasonCd"`
-}
-
-// XSD SimpleType declarations
-
-type StringType string
-
-type Uritype string
-
-type DateType string
-
-type YearMonthType string
-
-type TaxYearEndMonthDtType string
-
-type MonthType string
-
-type MonthDayType string
-
-type QuarterEndDateType string
-
-type TimestampType string
-
-type YearType string
-
-type BooleanType bool
-
-type CheckboxType string
-
-const CheckboxTypeX CheckboxType = "X"
-
-type IntegerType int64
-
-type IntegerNntype uint64
-
-type IntegerPosType uint64
-
-type LongIntegerType int64
-
-type DecimalType float64
-
-type DecimalNntype float64
-
-type UsamountType int64
-
-type UsamountNntype uint64
-
-type UsamountNegType int64
-
-type UsamountNonPosType int64
-
-type UsamountPosType uint64
-
-type UsdecimalAmountType float64
-
-type UsdecimalAmountNntype float64
-
-type UsdecimalAmountPosType float64
-
-type UslargeDecimalAmountType float64
-
-type ForeignAmountType int64
-
-type ForeignAmountNntype uint64
-
-type Decimal2RatioType float64
-
-type Decimal4RatioType float64
-
-type SmallRatioType float64
-
-type RatioType float64
-
-type LargeRatioType float64
-
-type BusinessActivityCodeType int64
-
-type RoutingTransitNumberType string
-
-type BankAccountNumberType string
-
-type BankAccountType string
-
-const BankAccountType1 BankAccountType = "1"
-
-const BankAccountType2 BankAccountType = "2"
-
-type Ssntype string
-
-type Eintype string
-
-type Etintype string
-
-type Ptintype string
-
-type Efintype string
-
-type Pintype string
-
-type Isptype string
-
-type OriginatorType string
-
-const OriginatorTypeEro OriginatorType = "ERO"
-
-const OriginatorTypeOnlinefiler OriginatorType = "OnlineFiler"
-
-const OriginatorTypeReportingagent OriginatorType = "ReportingAgent"
-
-const OriginatorTypeFinancialagent OriginatorType = "FinancialAgent"
-
-const OriginatorTypeLargetaxpayer OriginatorType = "LargeTaxpayer"
-
-type SignatureType string
-
-type SoftwareIdType string
-
-type SoftwareVersionType string
-
-type IdType string
-
-type IdListType string
-
-type BusinessNameLine1Type string
-
-type BusinessNameLine2Type string
-
-type InCareOfNameType string
-
-type NameLine1Type string
-
-type PersonNameType string
-
-type PersonTitleType string
-
-type BusinessNameControlType string
-
-type CheckDigitType string
-
-type StreetAddressType string
-
-type CityType string
-
-type StateType string
-
-const StateTypeAl StateType = "AL"
-
-const StateTypeAk StateType = "AK"
-
-const StateTypeAs StateType = "AS"
-
-const StateTypeAz StateType = "AZ"
-
-const StateTypeAr StateType = "AR"
-
-const StateTypeCa StateType = "CA"
-
-const StateTypeCo StateType = "CO"
-
-const StateTypeMp StateType = "MP"
-
-const StateTypeCt StateType = "CT"
-
-const StateTypeDe StateType = "DE"
-
-const StateTypeDc StateType = "DC"
-
-const StateTypeFm StateType = "FM"
-
-const StateTypeFl StateType = "FL"
-
-const StateTypeGa StateType = "GA"
-
-const StateTypeGu StateType = "GU"
-
-const StateTypeHi StateType = "HI"
-
-const StateTypeId StateType = "ID"
-
-const StateTypeIl StateType = "IL"
-
-const StateTypeIn StateType = "IN"
-
-const StateTypeIa StateType = "IA"
-
-const StateTypeKs StateType = "KS"
-
-const StateTypeKy StateType = "KY"
-
-const StateTypeLa StateType = "LA"
-
-const StateTypeMe StateType = "ME"
-
-const StateTypeMh StateType = "MH"
-
-const StateTypeMd StateType = "MD"
-
-const StateTypeMa StateType = "MA"
-
-const StateTypeMi StateType = "MI"
-
-const StateTypeMn StateType = "MN"
-
-const StateTypeMs StateType = "MS"
-
-const StateTypeMo StateType = "MO"
-
-const StateTypeMt StateType = "MT"
-
-const StateTypeNe StateType = "NE"
-
-const StateTypeNv StateType = "NV"
-
-const StateTypeNh StateType = "NH"
-
-const StateTypeNj StateType = "NJ"
-
-const StateTypeNm StateType = "NM"
-
-const StateTypeNy StateType = "NY"
-
-const StateTypeNc StateType = "NC"
-
-const StateTypeNd StateType = "ND"
-
-const StateTypeOh StateType = "OH"
-
-const StateTypeOk StateType = "OK"
-
-const StateTypeOr StateType = "OR"
-
-const StateTypePw StateType = "PW"
-
-const StateTypePa StateType = "PA"
-
-const StateTypePr StateType = "PR"
-
-const StateTypeRi StateType = "RI"
-
-const StateTypeSc StateType = "SC"
-
-const StateTypeSd StateType = "SD"
-
-const StateTypeTn StateType = "TN"
-
-const StateTypeTx StateType = "TX"
-
-const StateTypeVi StateType = "VI"
-
-const StateTypeUt StateType = "UT"
-
-const StateTypeVt StateType = "VT"
-
-const StateTypeVa StateType = "VA"
-
-const StateTypeWa StateType = "WA"
-
-const StateTypeWv StateType = "WV"
-
-const StateTypeWi StateType = "WI"
-
-const StateTypeWy StateType = "WY"
-
-const StateTypeAa StateType = "AA"
-
-const StateTypeAe StateType = "AE"
-
-const StateTypeAp StateType = "AP"
-
-type FutastateCdType string
-
-const FutastateCdTypeAl FutastateCdType = "AL"
-
-const FutastateCdTypeAk FutastateCdType = "AK"
-
-const FutastateCdTypeAz FutastateCdType = "AZ"
-
-const FutastateCdTypeAr FutastateCdType = "AR"
-
-const FutastateCdTypeCa FutastateCdType = "CA"
-
-const FutastateCdTypeCo FutastateCdType = "CO"
-
-const FutastateCdTypeCt FutastateCdType = "CT"
-
-const FutastateCdTypeDe FutastateCdType = "DE"
-
-const FutastateCdTypeDc FutastateCdType = "DC"
-
-const FutastateCdTypeFl FutastateCdType = "FL"
-
-const FutastateCdTypeGa FutastateCdType = "GA"
-
-const FutastateCdTypeHi FutastateCdType = "HI"
-
-const FutastateCdTypeId FutastateCdType = "ID"
-
-const FutastateCdTypeIl FutastateCdType = "IL"
-
-const FutastateCdTypeIn FutastateCdType = "IN"
-
-const FutastateCdTypeIa FutastateCdType = "IA"
-
-const FutastateCdTypeKs FutastateCdType = "KS"
-
-const FutastateCdTypeKy FutastateCdType = "KY"
-
-const FutastateCdTypeLa FutastateCdType = "LA"
-
-const FutastateCdTypeMe FutastateCdType = "ME"
-
-const FutastateCdTypeMd FutastateCdType = "MD"
-
-const FutastateCdTypeMa FutastateCdType = "MA"
-
-const FutastateCdTypeMi FutastateCdType = "MI"
-
-const FutastateCdTypeMn FutastateCdType = "MN"
-
-const FutastateCdTypeMs FutastateCdType = "MS"
-
-const FutastateCdTypeMo FutastateCdType = "MO"
-
-const FutastateCdTypeMt FutastateCdType = "MT"
-
-const FutastateCdTypeNe FutastateCdType = "NE"
-
-const FutastateCdTypeNv FutastateCdType = "NV"
-
-const FutastateCdTypeNh FutastateCdType = "NH"
-
-const FutastateCdTypeNj FutastateCdType = "NJ"
-
-const FutastateCdTypeNm FutastateCdType = "NM"
-
-const FutastateCdTypeNy FutastateCdType = "NY"
-
-const FutastateCdTypeNc FutastateCdType = "NC"
-
-const FutastateCdTypeNd FutastateCdType = "ND"
-
-const FutastateCdTypeOh FutastateCdType = "OH"
-
-const FutastateCdTypeOk FutastateCdType = "OK"
-
-const FutastateCdTypeOr FutastateCdType = "OR"
-
-const FutastateCdTypePa FutastateCdType = "PA"
-
-const FutastateCdTypePr FutastateCdType = "PR"
-
-const FutastateCdTypeRi FutastateCdType = "RI"
-
-const FutastateCdTypeSc FutastateCdType = "SC"
-
-const FutastateCdTypeSd FutastateCdType = "SD"
-
-const FutastateCdTypeTn FutastateCdType = "TN"
-
-const FutastateCdTypeTx FutastateCdType = "TX"
-
-const FutastateCdTypeVi FutastateCdType = "VI"
-
-const FutastateCdTypeUt FutastateCdType = "UT"
-
-const FutastateCdTypeVt FutastateCdType = "VT"
-
-const FutastateCdTypeVa FutastateCdType = "VA"
-
-const FutastateCdTypeWa FutastateCdType = "WA"
-
-const FutastateCdTypeWv FutastateCdType = "WV"
-
-const FutastateCdTypeWi FutastateCdType = "WI"
-
-const FutastateCdTypeWy FutastateCdType = "WY"
-
-type ZipcodeType string
-
-type PhoneNumberType string
-
-type ForeignPhoneNumberType string
-
-type EmailAddressType string
-
-type TextType string
-
-type ExplanationType string
-
-type LineExplanationType string
-
-type ShortExplanationType string
-
-type ShortDescriptionType string
-
-type MediumExplanationType string
-
-type LongExplanationType string
-
-type CountryType string
-
-const CountryTypeAf CountryType = "AF"
-
-const CountryTypeAx CountryType = "AX"
-
-const CountryTypeAl CountryType = "AL"
-
-const CountryTypeAg CountryType = "AG"
-
-const CountryTypeAq CountryType = "AQ"
-
-const CountryTypeAn CountryType = "AN"
-
-const CountryTypeAo CountryType = "AO"
-
-const CountryTypeAv CountryType = "AV"
-
-const CountryTypeAy CountryType = "AY"
-
-const CountryTypeAc CountryType = "AC"
-
-const CountryTypeAr CountryType = "AR"
-
-const CountryTypeAm CountryType = "AM"
-
-const CountryTypeAa CountryType = "AA"
-
-const CountryTypeAt CountryType = "AT"
-
-const CountryTypeAs CountryType = "AS"
-
-const CountryTypeAu CountryType = "AU"
-
-const CountryTypeAj CountryType = "AJ"
-
-const CountryTypeBf CountryType = "BF"
-
-const CountryTypeBa CountryType = "BA"
-
-const CountryTypeFq CountryType = "FQ"
-
-const CountryTypeBg CountryType = "BG"
-
-const CountryTypeBb CountryType = "BB"
-
-const CountryTypeBo CountryType = "BO"
-
-const CountryTypeBe CountryType = "BE"
-
-const CountryTypeBh CountryType = "BH"
-
-const CountryTypeBn CountryType = "BN"
-
-const CountryTypeBd CountryType = "BD"
-
-const CountryTypeBt CountryType = "BT"
-
-const CountryTypeBl CountryType = "BL"
-
-const CountryTypeBk CountryType = "BK"
-
-const CountryTypeBc CountryType = "BC"
-
-const CountryTypeBv CountryType = "BV"
-
-const CountryTypeBr CountryType = "BR"
-
-const CountryTypeIo CountryType = "IO"
-
-const CountryTypeVi CountryType = "VI"
-
-const CountryTypeBx CountryType = "BX"
-
-const CountryTypeBu CountryType = "BU"
-
-const CountryTypeUv CountryType = "UV"
-
-const CountryTypeBm CountryType = "BM"
-
-const CountryTypeBy CountryType = "BY"
-
-const CountryTypeCb CountryType = "CB"
-
-const CountryTypeCm CountryType = "CM"
-
-const CountryTypeCa CountryType = "CA"
-
-const CountryTypeCv CountryType = "CV"
-
-const CountryTypeCj CountryType = "CJ"
-
-const CountryTypeCt CountryType = "CT"
-
-const CountryTypeCd CountryType = "CD"
-
-const CountryTypeCi CountryType = "CI"
-
-const CountryTypeCh CountryType = "CH"
-
-const CountryTypeKt CountryType = "KT"
-
-const CountryTypeIp CountryType = "IP"
-
-const CountryTypeCk CountryType = "CK"
-
-const CountryTypeCo CountryType = "CO"
-
-const CountryTypeCn CountryType = "CN"
-
-const CountryTypeCf CountryType = "CF"
-
-const CountryTypeCg CountryType = "CG"
-
-const CountryTypeCw CountryType = "CW"
-
-const CountryTypeCr CountryType = "CR"
-
-const CountryTypeCs CountryType = "CS"
-
-const CountryTypeIv CountryType = "IV"
-
-const CountryTypeHr CountryType = "HR"
-
-const CountryTypeCu CountryType = "CU"
-
-const CountryTypeUc CountryType = "UC"
-
-const CountryTypeCy CountryType = "CY"
-
-const CountryTypeEz CountryType = "EZ"
-
-const CountryTypeDa CountryType = "DA"
-
-const CountryTypeDx CountryType = "DX"
-
-const CountryTypeDj CountryType = "DJ"
-
-const CountryTypeDo CountryType = "DO"
-
-const CountryTypeDr CountryType = "DR"
-
-const CountryTypeTt CountryType = "TT"
-
-const CountryTypeEc CountryType = "EC"
-
-const CountryTypeEg CountryType = "EG"
-
-const CountryTypeEs CountryType = "ES"
-
-const CountryTypeEk CountryType = "EK"
-
-const CountryTypeEr CountryType = "ER"
-
-const CountryTypeEn CountryType = "EN"
-
-const CountryTypeEt CountryType = "ET"
-
-const CountryTypeFk CountryType = "FK"
-
-const CountryTypeFo CountryType = "FO"
-
-const CountryTypeFm CountryType = "FM"
-
-const CountryTypeFj CountryType = "FJ"
-
-const CountryTypeFi CountryType = "FI"
-
-const CountryTypeFr CountryType = "FR"
-
-const CountryTypeFp CountryType = "FP"
-
-const CountryTypeFs CountryType = "FS"
-
-const CountryTypeGb CountryType = "GB"
-
-const CountryTypeGa CountryType = "GA"
-
-const CountryTypeGg CountryType = "GG"
-
-const CountryTypeGm CountryType = "GM"
-
-const CountryTypeGh CountryType = "GH"
-
-const CountryTypeGi CountryType = "GI"
-
-const CountryTypeGr CountryType = "GR"
-
-const CountryTypeGl CountryType = "GL"
-
-const CountryTypeGj CountryType = "GJ"
-
-const CountryTypeGq CountryType = "GQ"
-
-const CountryTypeGt CountryType = "GT"
-
-const CountryTypeGk CountryType = "GK"
-
-const CountryTypeGv CountryType = "GV"
-
-const CountryTypePu CountryType = "PU"
-
-const CountryTypeGy CountryType = "GY"
-
-const CountryTypeHa CountryType = "HA"
-
-const CountryTypeHm CountryType = "HM"
-
-const CountryTypeVt CountryType = "VT"
-
-const CountryTypeHo CountryType = "HO"
-
-const CountryTypeHk CountryType = "HK"
-
-const CountryTypeHq CountryType = "HQ"
-
-const CountryTypeHu CountryType = "HU"
-
-const CountryTypeIc CountryType = "IC"
-
-const CountryTypeIn CountryType = "IN"
-
-const CountryTypeId CountryType = "ID"
-
-const CountryTypeIr CountryType = "IR"
-
-const CountryTypeIz CountryType = "IZ"
-
-const CountryTypeEi CountryType = "EI"
-
-const CountryTypeIs CountryType = "IS"
-
-const CountryTypeIt CountryType = "IT"
-
-const CountryTypeJm CountryType = "JM"
-
-const CountryTypeJn CountryType = "JN"
-
-const CountryTypeJa CountryType = "JA"
-
-const CountryTypeDq CountryType = "DQ"
-
-const CountryTypeJe CountryType = "JE"
-
-const CountryTypeJq CountryType = "JQ"
-
-const CountryTypeJo CountryType = "JO"
-
-const CountryTypeKz CountryType = "KZ"
-
-const CountryTypeKe CountryType = "KE"
-
-const CountryTypeKq CountryType = "KQ"
-
-const CountryTypeKr CountryType = "KR"
-
-const CountryTypeKn CountryType = "KN"
-
-const CountryTypeKs CountryType = "KS"
-
-const CountryTypeKv CountryType = "KV"
-
-const CountryTypeKu CountryType = "KU"
-
-const CountryTypeKg CountryType = "KG"
-
-const CountryTypeLa CountryType = "LA"
-
-const CountryTypeLg CountryType = "LG"
-
-const CountryTypeLe CountryType = "LE"
-
-const CountryTypeLt CountryType = "LT"
-
-const CountryTypeLi CountryType = "LI"
-
-const CountryTypeLy CountryType = "LY"
-
-const CountryTypeLs CountryType = "LS"
-
-const CountryTypeLh CountryType = "LH"
-
-const CountryTypeLu CountryType = "LU"
-
-const CountryTypeMc CountryType = "MC"
-
-const CountryTypeMk CountryType = "MK"
-
-const CountryTypeMa CountryType = "MA"
-
-const CountryTypeMi CountryType = "MI"
-
-const CountryTypeMy CountryType = "MY"
-
-const CountryTypeMv CountryType = "MV"
-
-const CountryTypeMl CountryType = "ML"
-
-const CountryTypeMt CountryType = "MT"
-
-const CountryTypeIm CountryType = "IM"
-
-const CountryTypeRm CountryType = "RM"
-
-const CountryTypeMr CountryType = "MR"
-
-const CountryTypeMp CountryType = "MP"
-
-const CountryTypeMx CountryType = "MX"
-
-const CountryTypeMq CountryType = "MQ"
-
-const CountryTypeMd CountryType = "MD"
-
-const CountryTypeMn CountryType = "MN"
-
-const CountryTypeMg CountryType = "MG"
-
-const CountryTypeMj CountryType = "MJ"
-
-const CountryTypeMh CountryType = "MH"
-
-const CountryTypeMo CountryType = "MO"
-
-const CountryTypeMz CountryType = "MZ"
-
-const CountryTypeWa CountryType = "WA"
-
-const CountryTypeNr CountryType = "NR"
-
-const CountryTypeBq CountryType = "BQ"
-
-const CountryTypeNp CountryType = "NP"
-
-const CountryTypeNl CountryType = "NL"
-
-const CountryTypeNc CountryType = "NC"
-
-const CountryTypeNz CountryType = "NZ"
-
-const CountryTypeNu CountryType = "NU"
-
-const CountryTypeNg CountryType = "NG"
-
-const CountryTypeNi CountryType = "NI"
-
-const CountryTypeNe CountryType = "NE"
-
-const CountryTypeNf CountryType = "NF"
-
-const CountryTypeCq CountryType = "CQ"
-
-const CountryTypeNo CountryType = "NO"
-
-const CountryTypeMu CountryType = "MU"
-
-const CountryTypeOc CountryType = "OC"
-
-const CountryTypePk CountryType = "PK"
-
-const CountryTypePs CountryType = "PS"
-
-const CountryTypeLq CountryType = "LQ"
-
-const CountryTypePm CountryType = "PM"
-
-const CountryTypePp CountryType = "PP"
-
-const CountryTypePf CountryType = "PF"
-
-const CountryTypePa CountryType = "PA"
-
-const CountryTypePe CountryType = "PE"
-
-const CountryTypeRp CountryType = "RP"
-
-const CountryTypePc CountryType = "PC"
-
-const CountryTypePl CountryType = "PL"
-
-const CountryTypePo CountryType = "PO"
-
-const CountryTypeRq CountryType = "RQ"
-
-const CountryTypeQa CountryType = "QA"
-
-const CountryTypeRo CountryType = "RO"
-
-const CountryTypeRs CountryType = "RS"
-
-const CountryTypeRw CountryType = "RW"
-
-const CountryTypeTb CountryType = "TB"
-
-const CountryTypeRn CountryType = "RN"
-
-const CountryTypeWs CountryType = "WS"
-
-const CountryTypeSm CountryType = "SM"
-
-const CountryTypeTp CountryType = "TP"
-
-const CountryTypeSa CountryType = "SA"
-
-const CountryTypeSg CountryType = "SG"
-
-const CountryTypeRi CountryType = "RI"
-
-const CountryTypeSe CountryType = "SE"
-
-const CountryTypeSl CountryType = "SL"
-
-const CountryTypeSn CountryType = "SN"
-
-const CountryTypeNn CountryType = "NN"
-
-const CountryTypeLo CountryType = "LO"
-
-const CountryTypeSi CountryType = "SI"
-
-const CountryTypeBp CountryType = "BP"
-
-const CountryTypeSo CountryType = "SO"
-
-const CountryTypeSf CountryType = "SF"
-
-const CountryTypeSx CountryType = "SX"
-
-const CountryTypeOd CountryType = "OD"
-
-const CountryTypeSp CountryType = "SP"
-
-const CountryTypePg CountryType = "PG"
-
-const CountryTypeCe CountryType = "CE"
-
-const CountryTypeSh CountryType = "SH"
-
-const CountryTypeSc CountryType = "SC"
-
-const CountryTypeSt CountryType = "ST"
-
-const CountryTypeSb CountryType = "SB"
-
-const CountryTypeVc CountryType = "VC"
-
-const CountryTypeSu CountryType = "SU"
-
-const CountryTypeNs CountryType = "NS"
-
-const CountryTypeSv CountryType = "SV"
-
-const CountryTypeWz CountryType = "WZ"
-
-const CountryTypeSw CountryType = "SW"
-
-const CountryTypeSz CountryType = "SZ"
-
-const CountryTypeSy CountryType = "SY"
-
-const CountryTypeTw CountryType = "TW"
-
-const CountryTypeTi CountryType = "TI"
-
-const CountryTypeTz CountryType = "TZ"
-
-const CountryTypeTh CountryType = "TH"
-
-const CountryTypeTo CountryType = "TO"
-
-const CountryTypeTl CountryType = "TL"
-
-const CountryTypeTn CountryType = "TN"
-
-const CountryTypeTd CountryType = "TD"
-
-const CountryTypeTs CountryType = "TS"
-
-const CountryTypeTu CountryType = "TU"
-
-const CountryTypeTx CountryType = "TX"
-
-const CountryTypeTk CountryType = "TK"
-
-const CountryTypeTv CountryType = "TV"
-
-const CountryTypeUg CountryType = "UG"
-
-const CountryTypeUp CountryType = "UP"
-
-const CountryTypeAe CountryType = "AE"
-
-const CountryTypeUk CountryType = "UK"
-
-const CountryTypeUy CountryType = "UY"
-
-const CountryTypeUz CountryType = "UZ"
-
-const CountryTypeNh CountryType = "NH"
-
-const CountryTypeVe CountryType = "VE"
-
-const CountryTypeVm CountryType = "VM"
-
-const CountryTypeVq CountryType = "VQ"
-
-const CountryTypeWq CountryType = "WQ"
-
-const CountryTypeWf CountryType = "WF"
-
-const CountryTypeWi CountryType = "WI"
-
-const CountryTypeYm CountryType = "YM"
-
-const CountryTypeZa CountryType = "ZA"
-
-const CountryTypeZi CountryType = "ZI"
-
-type AllCountriesType string
-
-type DepreciationConventionCodeType string
-
-const DepreciationConventionCodeTypeHy DepreciationConventionCodeType = "HY"
-
-const DepreciationConventionCodeTypeMq DepreciationConventionCodeType = "MQ"
-
-const DepreciationConventionCodeTypeMm DepreciationConventionCodeType = "MM"
-
-const DepreciationConventionCodeTypeSl DepreciationConventionCodeType = "S/L"
-
-type DepreciationConventionType string
-
-type DepreciationMethodCodeType string
-
-const DepreciationMethodCodeType200Db DepreciationMethodCodeType = "200 DB"
-
-const DepreciationMethodCodeType150Db DepreciationMethodCodeType = "150 DB"
-
-const DepreciationMethodCodeTypeDb DepreciationMethodCodeType = "DB"
-
-const DepreciationMethodCodeTypeSl DepreciationMethodCodeType = "S/L"
-
-const DepreciationMethodCodeTypeVarious DepreciationMethodCodeType = "Various"
-
-type DepreciationMethodType string
-
-type Bintype string
-
-type GtxkeyType string
-
-type MethodOfAccountingType string
-
-const MethodOfAccountingTypeCash MethodOfAccountingType = "cash"
-
-const MethodOfAccountingTypeAccrual MethodOfAccountingType = "accrual"
-
-const MethodOfAccountingTypeHybrid MethodOfAccountingType = "hybrid"
-
-type NumericType string
-
-type AlphaNumericType string
-
-type AlphaNumericAndParenthesesType string
-
-type IrsserviceCenterType string
-
-type StringVarioustype string
-
-const StringVarioustypeVarious StringVarioustype = "VARIOUS"
-
-type CusipnumberType string
-
-type FuelGallonsType int64
-
-type FuelGallonsNntype uint64
-
-type MilesType int64
-
-type MilesNntype uint64
-
-type GovernmentCodeType string
-
-const GovernmentCodeTypeIrs GovernmentCodeType = "IRS"
-
-const GovernmentCodeTypeAlst GovernmentCodeType = "ALST"
-
-const GovernmentCodeTypeAkst GovernmentCodeType = "AKST"
-
-const GovernmentCodeTypeAzst GovernmentCodeType = "AZST"
-
-const GovernmentCodeTypeArst GovernmentCodeType = "ARST"
-
-const GovernmentCodeTypeCast GovernmentCodeType = "CAST"
-
-const GovernmentCodeTypeCnct GovernmentCodeType = "CNCT"
-
-const GovernmentCodeTypeCoct GovernmentCodeType = "COCT"
-
-const GovernmentCodeTypeCost GovernmentCodeType = "COST"
-
-const GovernmentCodeTypeCtst GovernmentCodeType = "CTST"
-
-const GovernmentCodeTypeDect GovernmentCodeType = "DECT"
-
-const GovernmentCodeTypeDest GovernmentCodeType = "DEST"
-
-const GovernmentCodeTypeDcst GovernmentCodeType = "DCST"
-
-const GovernmentCodeTypeFlst GovernmentCodeType = "FLST"
-
-const GovernmentCodeTypeGast GovernmentCodeType = "GAST"
-
-const GovernmentCodeTypeHist GovernmentCodeType = "HIST"
-
-const GovernmentCodeTypeIdst GovernmentCodeType = "IDST"
-
-const GovernmentCodeTypeIlst GovernmentCodeType = "ILST"
-
-const GovernmentCodeTypeInst GovernmentCodeType = "INST"
-
-const GovernmentCodeTypeIast GovernmentCodeType = "IAST"
-
-const GovernmentCodeTypeKact GovernmentCodeType = "KACT"
-
-const GovernmentCodeTypeKsst GovernmentCodeType = "KSST"
-
-const GovernmentCodeTypeKyst GovernmentCodeType = "KYST"
-
-const GovernmentCodeTypeLast GovernmentCodeType = "LAST"
-
-const GovernmentCodeTypeLect GovernmentCodeType = "LECT"
-
-const GovernmentCodeTypeLxct GovernmentCodeType = "LXCT"
-
-const GovernmentCodeTypeMest GovernmentCodeType = "MEST"
-
-const GovernmentCodeTypeMdst GovernmentCodeType = "MDST"
-
-const GovernmentCodeTypeMast GovernmentCodeType = "MAST"
-
-const GovernmentCodeTypeMist GovernmentCodeType = "MIST"
-
-const GovernmentCodeTypeMnst GovernmentCodeType = "MNST"
-
-const GovernmentCodeTypeMsst GovernmentCodeType = "MSST"
-
-const GovernmentCodeTypeMost GovernmentCodeType = "MOST"
-
-const GovernmentCodeTypeMtst GovernmentCodeType = "MTST"
-
-const GovernmentCodeTypeNest GovernmentCodeType = "NEST"
-
-const GovernmentCodeTypeNvst GovernmentCodeType = "NVST"
-
-const GovernmentCodeTypeNhst GovernmentCodeType = "NHST"
-
-const GovernmentCodeTypeNjst GovernmentCodeType = "NJST"
-
-const GovernmentCodeTypeNmst GovernmentCodeType = "NMST"
-
-const GovernmentCodeTypeNyst GovernmentCodeType = "NYST"
-
-const GovernmentCodeTypeNcst GovernmentCodeType = "NCST"
-
-const GovernmentCodeTypeNdst GovernmentCodeType = "NDST"
-
-const GovernmentCodeTypeOcct GovernmentCodeType = "OCCT"
-
-const GovernmentCodeTypeOhst GovernmentCodeType = "OHST"
-
-const GovernmentCodeTypeOkst GovernmentCodeType = "OKST"
-
-const GovernmentCodeTypeOrct GovernmentCodeType = "ORCT"
-
-const GovernmentCodeTypeOrst GovernmentCodeType = "ORST"
-
-const GovernmentCodeTypePast GovernmentCodeType = "PAST"
-
-const GovernmentCodeTypePoct GovernmentCodeType = "POCT"
-
-const GovernmentCodeTypeRist GovernmentCodeType = "RIST"
-
-const GovernmentCodeTypeScst GovernmentCodeType = "SCST"
-
-const GovernmentCodeTypeSdst GovernmentCodeType = "SDST"
-
-const GovernmentCodeTypeSlct GovernmentCodeType = "SLCT"
-
-const GovernmentCodeTypeTnst GovernmentCodeType = "TNST"
-
-const GovernmentCodeTypeToct GovernmentCodeType = "TOCT"
-
-const GovernmentCodeTypeTxst GovernmentCodeType = "TXST"
-
-const GovernmentCodeTypeUtst GovernmentCodeType = "UTST"
-
-const GovernmentCodeTypeVtst GovernmentCodeType = "VTST"
-
-const GovernmentCodeTypeVast GovernmentCodeType = "VAST"
-
-const GovernmentCodeTypeWast GovernmentCodeType = "WAST"
-
-const GovernmentCodeTypeWvst GovernmentCodeType = "WVST"
-
-const GovernmentCodeTypeWist GovernmentCodeType = "WIST"
-
-const GovernmentCodeTypeWyst GovernmentCodeType = "WYST"
-
-const GovernmentCodeTypeNyct GovernmentCodeType = "NYCT"
-
-const GovernmentCodeTypePhct GovernmentCodeType = "PHCT"
-
-type TaxShelterRegistrationType string
-
-type NumberOfSharesType int64
-
-type Vintype string
-
-type NumberOfDaysType int64
-
-type PinenteredByType string
-
-const PinenteredByTypeTaxpayer PinenteredByType = "Taxpayer"
-
-const PinenteredByTypeEro PinenteredByType = "ERO"
-
-type PincodeType string
-
-const PincodeTypePractitioner PincodeType = "Practitioner"
-
-const PincodeTypeSelfSelectPractitioner PincodeType = "Self-Select Practitioner"
-
-const PincodeTypeSelfSelectOnLine PincodeType = "Self-Select On-Line"
-
-type ConsortiumType string
-
-const ConsortiumTypeEnglishFreeFile ConsortiumType = "English Free-File"
-
-const ConsortiumTypeSpanishFreeFile ConsortiumType = "Spanish Free-File"
-
-const ConsortiumTypeFreeFillableForms ConsortiumType = "Free Fillable Forms"
-
-type PartnersPageFilingType string
-
-const PartnersPageFilingTypePartnersPageEnglish PartnersPageFilingType = "Partners Page English"
-
-const PartnersPageFilingTypePartnersPageSpanish PartnersPageFilingType = "Partners Page Spanish"
-
-type PersonFirstNameType string
-
-type PersonLastNameType string
-
-type PersonNameControlType string
-
-type Ipv4Type string
-
-type Ipv6Type string
-
-type TimeType string
-
-type TimezoneType string
-
-const TimezoneTypeUs TimezoneType = "US"
-
-const TimezoneTypeEs TimezoneType = "ES"
-
-const TimezoneTypeEd TimezoneType = "ED"
-
-const TimezoneTypeCs TimezoneType = "CS"
-
-const TimezoneTypeCd TimezoneType = "CD"
-
-const TimezoneTypeMs TimezoneType = "MS"
-
-const TimezoneTypeMd TimezoneType = "MD"
-
-const TimezoneTypePs TimezoneType = "PS"
-
-const TimezoneTypePd TimezoneType = "PD"
-
-const TimezoneTypeAs TimezoneType = "AS"
-
-const TimezoneTypeAd TimezoneType = "AD"
-
-const TimezoneTypeHs TimezoneType = "HS"
-
-const TimezoneTypeHd TimezoneType = "HD"
-
-type Stintype string
-
-type RegistrationNumType string
-
-type DeviceIdType string
-
-type ForeignRegulatedInvestmtCompCdType string
-
-const ForeignRegulatedInvestmtCompCdTypeRic ForeignRegulatedInvestmtCompCdType = "RIC"
-
-type SubmissionIdType string
-
-type Ftintype string
-
-type CurrencyCodeType string
-
-const CurrencyCodeTypeXua CurrencyCodeType = "XUA"
-
-const CurrencyCodeTypeAfn CurrencyCodeType = "AFN"
-
-const CurrencyCodeTypeDzd CurrencyCodeType = "DZD"
-
-const CurrencyCodeTypeArs CurrencyCodeType = "ARS"
-
-const CurrencyCodeTypeAmd CurrencyCodeType = "AMD"
-
-const CurrencyCodeTypeAwg CurrencyCodeType = "AWG"
-
-const CurrencyCodeTypeAud CurrencyCodeType = "AUD"
-
-const CurrencyCodeTypeAzn CurrencyCodeType = "AZN"
-
-const CurrencyCodeTypeBsd CurrencyCodeType = "BSD"
-
-const CurrencyCodeTypeBhd CurrencyCodeType = "BHD"
-
-const CurrencyCodeTypeThb CurrencyCodeType = "THB"
-
-const CurrencyCodeTypePab CurrencyCodeType = "PAB"
-
-const CurrencyCodeTypeBbd CurrencyCodeType = "BBD"
-
-const CurrencyCodeTypeByn CurrencyCodeType = "BYN"
-
-const CurrencyCodeTypeBzd CurrencyCodeType = "BZD"
-
-const CurrencyCodeTypeBmd CurrencyCodeType = "BMD"
-
-const CurrencyCodeTypeVes CurrencyCodeType = "VES"
-
-const CurrencyCodeTypeBob CurrencyCodeType = "BOB"
-
-const CurrencyCodeTypeBrl CurrencyCodeType = "BRL"
-
-const CurrencyCodeTypeBnd CurrencyCodeType = "BND"
-
-const CurrencyCodeTypeBgn CurrencyCodeType = "BGN"
-
-const CurrencyCodeTypeBif CurrencyCodeType = "BIF"
-
-const CurrencyCodeTypeCve CurrencyCodeType = "CVE"
-
-const CurrencyCodeTypeCad CurrencyCodeType = "CAD"
-
-const CurrencyCodeTypeKyd CurrencyCodeType = "KYD"
-
-const CurrencyCodeTypeXof CurrencyCodeType = "XOF"
-
-const CurrencyCodeTypeXaf CurrencyCodeType = "XAF"
-
-const CurrencyCodeTypeXpf CurrencyCodeType = "XPF"
-
-const CurrencyCodeTypeClp CurrencyCodeType = "CLP"
-
-const CurrencyCodeTypeCop CurrencyCodeType = "COP"
-
-const CurrencyCodeTypeKmf CurrencyCodeType = "KMF"
-
-const CurrencyCodeTypeCdf CurrencyCodeType = "CDF"
-
-const CurrencyCodeTypeBam CurrencyCodeType = "BAM"
-
-const CurrencyCodeTypeNio CurrencyCodeType = "NIO"
-
-const CurrencyCodeTypeCrc CurrencyCodeType = "CRC"
-
-const CurrencyCodeTypeCup CurrencyCodeType = "CUP"
-
-const CurrencyCodeTypeCzk CurrencyCodeType = "CZK"
-
-const CurrencyCodeTypeGmd CurrencyCodeType = "GMD"
-
-const CurrencyCodeTypeDkk CurrencyCodeType = "DKK"
-
-const CurrencyCodeTypeMkd CurrencyCodeType = "MKD"
-
-const CurrencyCodeTypeDjf CurrencyCodeType = "DJF"
-
-const CurrencyCodeTypeStn CurrencyCodeType = "STN"
-
-const CurrencyCodeTypeDop CurrencyCodeType = "DOP"
-
-const CurrencyCodeTypeVnd CurrencyCodeType = "VND"
-
-const CurrencyCodeTypeXcd CurrencyCodeType = "XCD"
-
-const CurrencyCodeTypeEgp CurrencyCodeType = "EGP"
-
-const CurrencyCodeTypeSvc CurrencyCodeType = "SVC"
-
-const CurrencyCodeTypeEtb CurrencyCodeType = "ETB"
-
-const CurrencyCodeTypeEur CurrencyCodeType = "EUR"
-
-const CurrencyCodeTypeFkp CurrencyCodeType = "FKP"
-
-const CurrencyCodeTypeFjd CurrencyCodeType = "FJD"
-
-const CurrencyCodeTypeHuf CurrencyCodeType = "HUF"
-
-const CurrencyCodeTypeGhs CurrencyCodeType = "GHS"
-
-const CurrencyCodeTypeGip CurrencyCodeType = "GIP"
-
-const CurrencyCodeTypeHtg CurrencyCodeType = "HTG"
-
-const CurrencyCodeTypePyg CurrencyCodeType = "PYG"
-
-const CurrencyCodeTypeGnf CurrencyCodeType = "GNF"
-
-const CurrencyCodeTypeGyd CurrencyCodeType = "GYD"
-
-const CurrencyCodeTypeHkd CurrencyCodeType = "HKD"
-
-const CurrencyCodeTypeUah CurrencyCodeType = "UAH"
-
-const CurrencyCodeTypeIsk CurrencyCodeType = "ISK"
-
-const CurrencyCodeTypeInr CurrencyCodeType = "INR"
-
-const CurrencyCodeTypeIrr CurrencyCodeType = "IRR"
-
-const CurrencyCodeTypeIqd CurrencyCodeType = "IQD"
-
-const CurrencyCodeTypeJmd CurrencyCodeType = "JMD"
-
-const CurrencyCodeTypeJod CurrencyCodeType = "JOD"
-
-const CurrencyCodeTypeKes CurrencyCodeType = "KES"
-
-const CurrencyCodeTypePgk CurrencyCodeType = "PGK"
-
-const CurrencyCodeTypeHrk CurrencyCodeType = "HRK"
-
-const CurrencyCodeTypeKwd CurrencyCodeType = "KWD"
-
-const CurrencyCodeTypeAoa CurrencyCodeType = "AOA"
-
-const CurrencyCodeTypeMmk CurrencyCodeType = "MMK"
-
-const CurrencyCodeTypeLak CurrencyCodeType = "LAK"
-
-const CurrencyCodeTypeGel CurrencyCodeType = "GEL"
-
-const CurrencyCodeTypeLbp CurrencyCodeType = "LBP"
-
-const CurrencyCodeTypeAll CurrencyCodeType = "ALL"
-
-const CurrencyCodeTypeHnl CurrencyCodeType = "HNL"
-
-const CurrencyCodeTypeSll CurrencyCodeType = "SLL"
-
-const CurrencyCodeTypeLrd CurrencyCodeType = "LRD"
-
-const CurrencyCodeTypeLyd CurrencyCodeType = "LYD"
-
-const CurrencyCodeTypeSzl CurrencyCodeType = "SZL"
-
-const CurrencyCodeTypeLsl CurrencyCodeType = "LSL"
-
-const CurrencyCodeTypeMga CurrencyCodeType = "MGA"
-
-const CurrencyCodeTypeMwk CurrencyCodeType = "MWK"
-
-const CurrencyCodeTypeMyr CurrencyCodeType = "MYR"
-
-const CurrencyCodeTypeMur CurrencyCodeType = "MUR"
-
-const CurrencyCodeTypeMxn CurrencyCodeType = "MXN"
-
-const CurrencyCodeTypeMxv CurrencyCodeType = "MXV"
-
-const CurrencyCodeTypeMdl CurrencyCodeType = "MDL"
-
-const CurrencyCodeTypeMad CurrencyCodeType = "MAD"
-
-const CurrencyCodeTypeMzn CurrencyCodeType = "MZN"
-
-const CurrencyCodeTypeBov CurrencyCodeType = "BOV"
-
-const CurrencyCodeTypeNgn CurrencyCodeType = "NGN"
-
-const CurrencyCodeTypeErn CurrencyCodeType = "ERN"
-
-const CurrencyCodeTypeNad CurrencyCodeType = "NAD"
-
-const CurrencyCodeTypeNpr CurrencyCodeType = "NPR"
-
-const CurrencyCodeTypeAng CurrencyCodeType = "ANG"
-
-const CurrencyCodeTypeIls CurrencyCodeType = "ILS"
-
-const CurrencyCodeTypeTwd CurrencyCodeType = "TWD"
-
-const CurrencyCodeTypeNzd CurrencyCodeType = "NZD"
-
-const CurrencyCodeTypeBtn CurrencyCodeType = "BTN"
-
-const CurrencyCodeTypeKpw CurrencyCodeType = "KPW"
-
-const CurrencyCodeTypeNok CurrencyCodeType = "NOK"
-
-const CurrencyCodeTypeMru CurrencyCodeType = "MRU"
-
-const CurrencyCodeTypeTop CurrencyCodeType = "TOP"
-
-const CurrencyCodeTypePkr CurrencyCodeType = "PKR"
-
-const CurrencyCodeTypeMop CurrencyCodeType = "MOP"
-
-const CurrencyCodeTypeCuc CurrencyCodeType = "CUC"
-
-const CurrencyCodeTypeUyu CurrencyCodeType = "UYU"
-
-const CurrencyCodeTypePhp CurrencyCodeType = "PHP"
-
-const CurrencyCodeTypeGbp CurrencyCodeType = "GBP"
-
-const CurrencyCodeTypeBwp CurrencyCodeType = "BWP"
-
-const CurrencyCodeTypeQar CurrencyCodeType = "QAR"
-
-const CurrencyCodeTypeGtq CurrencyCodeType = "GTQ"
-
-const CurrencyCodeTypeZar CurrencyCodeType = "ZAR"
-
-const CurrencyCodeTypeOmr CurrencyCodeType = "OMR"
-
-const CurrencyCodeTypeKhr CurrencyCodeType = "KHR"
-
-const CurrencyCodeTypeRon CurrencyCodeType = "RON"
-
-const CurrencyCodeTypeMvr CurrencyCodeType = "MVR"
-
-const CurrencyCodeTypeIdr CurrencyCodeType = "IDR"
-
-const CurrencyCodeTypeRub CurrencyCodeType = "RUB"
-
-const CurrencyCodeTypeRwf CurrencyCodeType = "RWF"
-
-const CurrencyCodeTypeShp CurrencyCodeType = "SHP"
-
-const CurrencyCodeTypeSar CurrencyCodeType = "SAR"
-
-const CurrencyCodeTypeXdr CurrencyCodeType = "XDR"
-
-const CurrencyCodeTypeRsd CurrencyCodeType = "RSD"
-
-const CurrencyCodeTypeScr CurrencyCodeType = "SCR"
-
-const CurrencyCodeTypeSgd CurrencyCodeType = "SGD"
-
-const CurrencyCodeTypePen CurrencyCodeType = "PEN"
-
-const CurrencyCodeTypeSbd CurrencyCodeType = "SBD"
-
-const CurrencyCodeTypeKgs CurrencyCodeType = "KGS"
-
-const CurrencyCodeTypeSos CurrencyCodeType = "SOS"
-
-const CurrencyCodeTypeTjs CurrencyCodeType = "TJS"
-
-const CurrencyCodeTypeSsp CurrencyCodeType = "SSP"
-
-const CurrencyCodeTypeLkr CurrencyCodeType = "LKR"
-
-const CurrencyCodeTypeXsu CurrencyCodeType = "XSU"
-
-const CurrencyCodeTypeSdg CurrencyCodeType = "SDG"
-
-const CurrencyCodeTypeSrd CurrencyCodeType = "SRD"
-
-const CurrencyCodeTypeSek CurrencyCodeType = "SEK"
-
-const CurrencyCodeTypeChf CurrencyCodeType = "CHF"
-
-const CurrencyCodeTypeSyp CurrencyCodeType = "SYP"
-
-const CurrencyCodeTypeBdt CurrencyCodeType = "BDT"
-
-const CurrencyCodeTypeWst CurrencyCodeType = "WST"
-
-const CurrencyCodeTypeTzs CurrencyCodeType = "TZS"
-
-const CurrencyCodeTypeKzt CurrencyCodeType = "KZT"
-
-const CurrencyCodeTypeTtd CurrencyCodeType = "TTD"
-
-const CurrencyCodeTypeMnt CurrencyCodeType = "MNT"
-
-const CurrencyCodeTypeTnd CurrencyCodeType = "TND"
-
-const CurrencyCodeTypeTry CurrencyCodeType = "TRY"
-
-const CurrencyCodeTypeTmt CurrencyCodeType = "TMT"
-
-const CurrencyCodeTypeAed CurrencyCodeType = "AED"
-
-const CurrencyCodeTypeUgx CurrencyCodeType = "UGX"
-
-const CurrencyCodeTypeClf CurrencyCodeType = "CLF"
-
-const CurrencyCodeTypeCou CurrencyCodeType = "COU"
-
-const CurrencyCodeTypeUyw CurrencyCodeType = "UYW"
-
-const CurrencyCodeTypeUyi CurrencyCodeType = "UYI"
-
-const CurrencyCodeTypeUsd CurrencyCodeType = "USD"
-
-const CurrencyCodeTypeUsn CurrencyCodeType = "USN"
-
-const CurrencyCodeTypeUzs CurrencyCodeType = "UZS"
-
-const CurrencyCodeTypeVuv CurrencyCodeType = "VUV"
-
-const CurrencyCodeTypeChe CurrencyCodeType = "CHE"
-
-const CurrencyCodeTypeChw CurrencyCodeType = "CHW"
-
-const CurrencyCodeTypeKrw CurrencyCodeType = "KRW"
-
-const CurrencyCodeTypeYer CurrencyCodeType = "YER"
-
-const CurrencyCodeTypeJpy CurrencyCodeType = "JPY"
-
-const CurrencyCodeTypeCny CurrencyCodeType = "CNY"
-
-const CurrencyCodeTypeZmw CurrencyCodeType = "ZMW"
-
-const CurrencyCodeTypeZwl CurrencyCodeType = "ZWL"
-
-const CurrencyCodeTypePln CurrencyCodeType = "PLN"
-
-type PtepgroupCodeType string
-
-const PtepgroupCodeTypeR965A PtepgroupCodeType = "R965a"
-
-const PtepgroupCodeTypeR965B PtepgroupCodeType = "R965b"
-
-const PtepgroupCodeType959C1 PtepgroupCodeType = "959c1"
-
-const PtepgroupCodeTypeR951A PtepgroupCodeType = "R951A"
-
-const PtepgroupCodeTypeR245Ad PtepgroupCodeType = "R245Ad"
-
-const PtepgroupCodeType965A PtepgroupCodeType = "965a"
-
-const PtepgroupCodeType965B PtepgroupCodeType = "965b"
-
-const PtepgroupCodeType951A PtepgroupCodeType = "951A"
-
-const PtepgroupCodeType245Ad PtepgroupCodeType = "245Ad"
-
-const PtepgroupCodeType951A1A PtepgroupCodeType = "951a1A"
-
-type StockDescriptionType string
-
-type Decimal1RatioType float64
-
-type Decimal3RatioType float64
-
-type AlphaRowIdType string
-
-type RomanRowIdType string
-
-type NumericRowIdType uint64
-
-type AartrackingNumberType string
-
-type FacilityIrsissdRegistrationNumType string
-
-type LatitudeCoordinateType float64
-
-type LongitudeCoordinateType float64
-
-type NpsprojectNumType string
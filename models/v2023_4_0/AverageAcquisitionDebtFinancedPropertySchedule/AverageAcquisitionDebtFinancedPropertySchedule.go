@@ -0,0 +1,90 @@
+// Code generated by https://github.com/gocomply/xsd2go and the schemas command; DO NOT EDIT.
+// Models for http://www.irs.gov/efile
+package AverageAcquisitionDebtFinancedPropertySchedule
+
+import (
+	"encoding/xml"
+	"github.com/synergos-systems/models/v2023_4_0/common"
+	"github.com/synergos-systems/xsd"
+)
+
+// Element
+type AvgAcquisDebtFincdPropSch struct {
+	XMLName xml.Name `xml:"AvgAcquisDebtFincdPropSch"`
+
+	DocumentName string `xml:"documentName,attr"`
+
+	DocumentId common.IdType `xml:"documentId,attr"`
+
+	SoftwareId common.SoftwareIdType `xml:"softwareId,attr"`
+
+	SoftwareVersionNum common.SoftwareVersionType `xml:"softwareVersionNum,attr"`
+
+	AvgAcquisDebtFincdPropGrp []AvgAcquisDebtFincdPropGrpType `xml:",any"`
+}
+
+type AvgAcquisDebtFincdPropSchType struct {
+	XMLName xml.Name
+
+	AvgAcquisDebtFincdPropGrp []AvgAcquisDebtFincdPropGrpType `xml:",any"`
+}
+
+type AvgAcquisDebtFincdPropGrpType struct {
+	XMLName xml.Name
+
+	PropertyLineNum string `xml:"PropertyLineNum"`
+
+	MonthlyAvgAcquisIndbtAmt *common.UsamountType `xml:"MonthlyAvgAcquisIndbtAmt"`
+
+	AllocableDebtFinancedIncomePct *common.RatioType `xml:"AllocableDebtFinancedIncomePct"`
+
+	AvgAcquisDebtFincdPropAmt *common.UsamountType `xml:"AvgAcquisDebtFincdPropAmt"`
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *AvgAcquisDebtFincdPropSch) Validate() error {
+	var errs xsd.Errors
+	if v.DocumentId != "" {
+		errs.Add("@documentId", v.DocumentId.Validate())
+	}
+	if v.SoftwareId != "" {
+		errs.Add("@softwareId", v.SoftwareId.Validate())
+	}
+	if v.SoftwareVersionNum != "" {
+		errs.Add("@softwareVersionNum", v.SoftwareVersionNum.Validate())
+	}
+	for i := range v.AvgAcquisDebtFincdPropGrp {
+		errs.Add(xsd.Index("AvgAcquisDebtFincdPropGrp", i), v.AvgAcquisDebtFincdPropGrp[i].Validate())
+	}
+	return errs.Err()
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *AvgAcquisDebtFincdPropSchType) Validate() error {
+	var errs xsd.Errors
+	for i := range v.AvgAcquisDebtFincdPropGrp {
+		errs.Add(xsd.Index("AvgAcquisDebtFincdPropGrp", i), v.AvgAcquisDebtFincdPropGrp[i].Validate())
+	}
+	return errs.Err()
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *AvgAcquisDebtFincdPropGrpType) Validate() error {
+	var errs xsd.Errors
+	if v.PropertyLineNum == "" {
+		errs.Add("PropertyLineNum", xsd.ErrMissing)
+	}
+	if v.MonthlyAvgAcquisIndbtAmt != nil {
+		errs.Add("MonthlyAvgAcquisIndbtAmt", v.MonthlyAvgAcquisIndbtAmt.Validate())
+	}
+	if v.AllocableDebtFinancedIncomePct != nil {
+		errs.Add("AllocableDebtFinancedIncomePct", v.AllocableDebtFinancedIncomePct.Validate())
+	}
+	if v.AvgAcquisDebtFincdPropAmt != nil {
+		errs.Add("AvgAcquisDebtFincdPropAmt", v.AvgAcquisDebtFincdPropAmt.Validate())
+	}
+	return errs.Err()
+}
@@ -0,0 +1,1016 @@
+// Code generated by the schemas command; DO NOT EDIT.
+
+// Package v2023_4_0 lists the top-level elements of the documents in one schema version
+package v2023_4_0
+
+import (
+	"github.com/synergos-systems/models/v2023_4_0/AccumulatedProfitsForTaxYearSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdditionalBondCurrentYearCreditStatement"
+	"github.com/synergos-systems/models/v2023_4_0/AdditionalSection263ACostSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdditionalSection263ACostsUnderCostofGoodsSoldSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdjustedBasisAllocableDebtFinancedPropertySchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdjustedGainLossSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdvertisingIncomeConsolidatedSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AdvertisingIncomeExcessSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/AffiliatedGroupFilingConsolidatedReturnStatement"
+	"github.com/synergos-systems/models/v2023_4_0/AllocationAndCapitalizationMethodsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/AmendedReturnChanges2"
+	"github.com/synergos-systems/models/v2023_4_0/AmortizationElectionStatement"
+	"github.com/synergos-systems/models/v2023_4_0/AppWithdrwNotPerfDndCnsntStmt"
+	"github.com/synergos-systems/models/v2023_4_0/AppealsFederalCourtExplanationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ApplcntNotRcvAudProtectionStmt"
+	"github.com/synergos-systems/models/v2023_4_0/ApplicantEligibleToChangeMethodOfAccountingStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ApplicantReceivedAuditProtectionForRequestedChangeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ApplicantsContractsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ApplicantsReasonForProposedChangeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/AverageAcquisitionDebtFinancedPropertySchedule"
+	"github.com/synergos-systems/models/v2023_4_0/BasisForEntitlementStatement"
+	"github.com/synergos-systems/models/v2023_4_0/BasisOtherThanActualCostOfPropertyStatement"
+	"github.com/synergos-systems/models/v2023_4_0/BinaryAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/BiodieselResellerStatement"
+	"github.com/synergos-systems/models/v2023_4_0/BusDisqualifiesAutoCnsntStmt"
+	"github.com/synergos-systems/models/v2023_4_0/CarryforwardGeneralBusinessCr"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeFromLIFOToNonLIFOMethodStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeInAccountingMethodOrPeriodForPast5YearsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeInOverallMethodBreakdownStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeInOverallMethodOfAccountingStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeInValuingInventoriesAdditionalInformationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeToCashMethodStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ChangeToIPICMethodStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CharitableContributionSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/CharitableContributionSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/CharitableContributionStatement2"
+	"github.com/synergos-systems/models/v2023_4_0/CodeSectUnderWhichPropIsDeprecOrAmortzStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ComputationOfMinimumTaxCreditStmt"
+	"github.com/synergos-systems/models/v2023_4_0/ComputationOfSection481aAdjustmentStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ConsolidatedGroupInformationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ControlledForeignPartnershipReportingStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ControlledGroupMemberStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ControlledGroupMembersStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CostComparisonOrMethodUsedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CostGoodSoldOtherCostSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/CostOtherThanActualCashCostStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CreditsRelatedToOtherRentalActivitiesStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CreditsRelatedToRentalREActivitiesStatement"
+	"github.com/synergos-systems/models/v2023_4_0/CurrencyConversionStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DebtFinancedExpenseSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/DeductionsConnectedRentalIncomeSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/DeductionsOtherCategoriesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/DeferralMethodAdvancePayments"
+	"github.com/synergos-systems/models/v2023_4_0/DescriptionOfInventoryGoodsBeingChangedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DescriptionOfInventoryGoodsNotBeingChangedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DescriptionOfPropertyBeingChangedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DieselWaterFuelEmulsionBlendingStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DispositionOfPropWithSect179DeductionsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DistributionsOfMoneyStatement"
+	"github.com/synergos-systems/models/v2023_4_0/DistributionsOfPropertyOtherThanMoneyStatement"
+	"github.com/synergos-systems/models/v2023_4_0/EarningsAndProfitsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/EvidenceOfDyedDieselFuelSoldStatement"
+	"github.com/synergos-systems/models/v2023_4_0/EvidenceOfDyedDieselFuelStatement"
+	"github.com/synergos-systems/models/v2023_4_0/EvidenceOfDyedKeroseneSoldStatement"
+	"github.com/synergos-systems/models/v2023_4_0/EvidenceOfDyedKeroseneStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ExceptionUnderSection460eStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ExpenseConnectedInvestmentIncome501c7917Schedule"
+	"github.com/synergos-systems/models/v2023_4_0/ExpensesFromOtherRentalActivitiesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ExplanationHowPropertyTreatedUnderPresentMethodStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ExploitedActivityNotUnrelatedBusinessIncomeSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ExploitedActivityUnrelatedBusinessIncomeSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/FactsSuprtProposedChgToDepreciateOrAmortizePropStmt"
+	"github.com/synergos-systems/models/v2023_4_0/FinancialServicesIncomeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignBranchIncomeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignGrossIncomeAtCorpLevelOtherCatSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignGrossIncomeAtPartnershipLevelOtherCategoriesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignTaxSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignTaxesPaidAccruedAndDeemedPaidStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ForeignTransactionStatement"
+	"github.com/synergos-systems/models/v2023_4_0/GenBusinessCreditComputation"
+	"github.com/synergos-systems/models/v2023_4_0/GeneralDependency"
+	"github.com/synergos-systems/models/v2023_4_0/GeneralDependencyMedium"
+	"github.com/synergos-systems/models/v2023_4_0/GeneralDependencySmall"
+	"github.com/synergos-systems/models/v2023_4_0/GrossIncomeSourcedAtShareholderLevelSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/GrossReceiptsInstallmentSalesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/IRS1041ScheduleD"
+	"github.com/synergos-systems/models/v2023_4_0/IRS1041ScheduleI"
+	"github.com/synergos-systems/models/v2023_4_0/IRS1120ScheduleD"
+	"github.com/synergos-systems/models/v2023_4_0/IRS1122"
+	"github.com/synergos-systems/models/v2023_4_0/IRS2439"
+	"github.com/synergos-systems/models/v2023_4_0/IRS3115"
+	"github.com/synergos-systems/models/v2023_4_0/IRS3800"
+	"github.com/synergos-systems/models/v2023_4_0/IRS4136"
+	"github.com/synergos-systems/models/v2023_4_0/IRS4562"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8801"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8827"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8834"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8873"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8902"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8912"
+	"github.com/synergos-systems/models/v2023_4_0/IRS8949"
+	"github.com/synergos-systems/models/v2023_4_0/IRS990N"
+	"github.com/synergos-systems/models/v2023_4_0/IRS990T"
+	"github.com/synergos-systems/models/v2023_4_0/IRS990TScheduleA"
+	"github.com/synergos-systems/models/v2023_4_0/IRSESPayment"
+	"github.com/synergos-systems/models/v2023_4_0/IRSPayment"
+	"github.com/synergos-systems/models/v2023_4_0/IncomeExpensesOtherPassiveRentalActivitiesStatement"
+	"github.com/synergos-systems/models/v2023_4_0/IncomeLossPartnershipSCorporationSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/IncomeReceivedOrReportedBeforeEarnedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/IncomeTaxReturnsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/InterestSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/InvntryValuationMthdStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedDeductionsNotChargedAgainstBooksSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedDeductionsPortfolioIncomeLossStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedExpensesRecordedOnBooksSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedIncomeNotRecordedOnBooksSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedIncomeRecordedOnBooksSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherAssetsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherCreditsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherCurrentAssetsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherCurrentLiabilitiesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherDeductionsSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherDeductionsSchedule3"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherIncomeLossSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherInvestmentsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedOtherLiabilitiesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ItemizedTotalForeignTaxesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/LIFOInventoryOtherThanCostStatement"
+	"github.com/synergos-systems/models/v2023_4_0/LegalBasisForChangeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ListOfOtherCostsDirectAndIndirectCostsAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/LocalUnitSpecificDeductionSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/LongTermContractsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/LongTermManufacturingContractsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/LowIncomeHousingCreditStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ManufacturingProposedPoolStatement"
+	"github.com/synergos-systems/models/v2023_4_0/MfrGoodsSoldOrDistributedStatement"
+	"github.com/synergos-systems/models/v2023_4_0/MixedStraddleAccountElectionStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ModelOAndModelSCertificateStatement"
+	"github.com/synergos-systems/models/v2023_4_0/MthdOfCostAllocationCostsNotFullyUnderSect263Aor460Stmt"
+	"github.com/synergos-systems/models/v2023_4_0/NetIncomeLossAtRiskREActySchedule"
+	"github.com/synergos-systems/models/v2023_4_0/NonAutomaticChangeRequestProceduresStatement"
+	"github.com/synergos-systems/models/v2023_4_0/NonaccrualExperienceMethodSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/NonconventionalSourceFuelCreditSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/NontaxableUseFuelsCreditCardUsersStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OpeningInventoryAdjustmentStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OrganizationChartStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OtherAdjustmentsAndTaxPreferenceItemsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherAmountsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OtherCostsNotRequiredToBeAllocatedAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/OtherCostsUnderCostofGoodsSoldSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherCreditPaymentSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherCreditsSchedule2"
+	"github.com/synergos-systems/models/v2023_4_0/OtherDeductionSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherExpensesAndDeductionsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherIdentificationAndValuationMethodsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OtherIncomeSchedule3"
+	"github.com/synergos-systems/models/v2023_4_0/OtherIncomeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OtherItemsAndAmountsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherPortfolioIncomeLossStatement"
+	"github.com/synergos-systems/models/v2023_4_0/OtherRecaptureCreditsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherReductionOfTaxesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherTaxAmountSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OtherTaxSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/OverallMethodOfAccountingAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/Owned10PercentInterestInForeignPartnershipStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ParentCorporationInformationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/PassiveActivityOtherIncomeLossSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/PassiveActivitySection1231GainLossStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Post1986UndistributedEarningsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/Post2017NOLSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/Pre2018NOLSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/PresentAndProposedLIFOMethodsStatment"
+	"github.com/synergos-systems/models/v2023_4_0/PresentHybridMethodOfAccountingAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/PresentMethodDifferenceExplanationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/PrivateLetterRulingChgInAcctMthdTechAdviceReqStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ProposedChangeInPoolingInventoriesStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ProposedChangeNotForLIFOInventoryStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ProposedChangeNotForLIFOPoolsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ProposedHybridMethodOfAccountingAttachment"
+	"github.com/synergos-systems/models/v2023_4_0/ProposedNaturalBusinessUnitStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ProxyTaxSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/QualifiedRehabilitationExpendituresStatement"
+	"github.com/synergos-systems/models/v2023_4_0/QualifyingCoreSecondaryOrIncidentalActivitiesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/REMICStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ReasonableCauseExplanation"
+	"github.com/synergos-systems/models/v2023_4_0/ReducedUserFeeStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ReductionInTaxesSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ReductionOfTaxesForSection6038cPenaltySchedule"
+	"github.com/synergos-systems/models/v2023_4_0/ReductionOfTaxesUnderSection901eSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/RequestToDeferAdvancePaymentUnderRegSect14515Stmt"
+	"github.com/synergos-systems/models/v2023_4_0/RequireUseCutOffBasisStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ReturnData990N"
+	"github.com/synergos-systems/models/v2023_4_0/ReturnHeader990N"
+	"github.com/synergos-systems/models/v2023_4_0/ReturnHeader990x"
+	"github.com/synergos-systems/models/v2023_4_0/SSBICElectionPostponedGainStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section1202ExclusionStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section1294AdjustmentSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/Section168f1PropertyExplanationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section179ZoneEnterprisePropertyStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section42j5Schedule"
+	"github.com/synergos-systems/models/v2023_4_0/Section481aAdjustmentStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section59e2ExpenditureStatement"
+	"github.com/synergos-systems/models/v2023_4_0/Section942a3Schedule"
+	"github.com/synergos-systems/models/v2023_4_0/SetAsideSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/SmallEthanolProducerCreditStatement"
+	"github.com/synergos-systems/models/v2023_4_0/StraightlineDepreciationSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/SupplementalInformationStatement"
+	"github.com/synergos-systems/models/v2023_4_0/TabularScheduleOfTransactions"
+	"github.com/synergos-systems/models/v2023_4_0/ToWhomDieselFuelSoldStatement"
+	"github.com/synergos-systems/models/v2023_4_0/ToWhomKeroseneFuelSoldStatement"
+	"github.com/synergos-systems/models/v2023_4_0/TradeOrBusinessStatement"
+	"github.com/synergos-systems/models/v2023_4_0/TransactionsInLieuOfTheFSCProvisionsSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/UnableToFurnishCopyOfForm970Statement"
+	"github.com/synergos-systems/models/v2023_4_0/UnrealizedAppreciationOfCollectiblesStatement"
+	"github.com/synergos-systems/models/v2023_4_0/UnrecapturedSection1250GainSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/UnusedCapitalLossCarryoverSchedule"
+	"github.com/synergos-systems/models/v2023_4_0/UseOfProposedMethodOfAccountingStatement"
+	"github.com/synergos-systems/models/v2023_4_0/WholesaleOrRetailGoodsProposedNumOfPoolsStatement"
+	"github.com/synergos-systems/models/v2023_4_0/efile"
+	"github.com/synergos-systems/models/v2023_4_0/efileAttachments"
+	"github.com/synergos-systems/models/v2023_4_0/efileMessageCommon"
+	"github.com/synergos-systems/models/v2023_4_0/efileMessageIFA"
+	"github.com/synergos-systems/models/v2023_4_0/tns"
+)
+
+// Documents makes a new model of each top-level element, by document and element name
+var Documents = map[string]map[string]func() any{
+	"AccumulatedProfitsForTaxYearSchedule": {
+		"AccumProfitsForTaxYearSchedule": func() any { return new(AccumulatedProfitsForTaxYearSchedule.AccumProfitsForTaxYearSchedule) },
+	},
+	"AdditionalBondCurrentYearCreditStatement": {
+		"AddnlBondCYCreditStatmnt": func() any { return new(AdditionalBondCurrentYearCreditStatement.AddnlBondCycreditStatmnt) },
+		"PrincipalBondAndCreditsGrp": func() any {
+			return new(AdditionalBondCurrentYearCreditStatement.AddnlBondCycreditStmtGrpPrincipalBondAndCreditsGrp)
+		},
+	},
+	"AdditionalSection263ACostSchedule": {
+		"AdditionalSection263ACostSch": func() any { return new(AdditionalSection263ACostSchedule.AdditionalSection263AcostSch) },
+	},
+	"AdditionalSection263ACostsUnderCostofGoodsSoldSchedule": {
+		"AddnlSection263ACostsSch": func() any {
+			return new(AdditionalSection263ACostsUnderCostofGoodsSoldSchedule.AddnlSection263AcostsSch)
+		},
+	},
+	"AdjustedBasisAllocableDebtFinancedPropertySchedule": {
+		"AdjBssAllcblDebtFincdPropSch": func() any {
+			return new(AdjustedBasisAllocableDebtFinancedPropertySchedule.AdjBssAllcblDebtFincdPropSch)
+		},
+	},
+	"AdjustedGainLossSchedule": {
+		"AdjustedGainLossSchedule": func() any { return new(AdjustedGainLossSchedule.AdjustedGainLossSchedule) },
+	},
+	"AdvertisingIncomeConsolidatedSchedule": {
+		"AdvertisingIncomeCnsldtSch": func() any { return new(AdvertisingIncomeConsolidatedSchedule.AdvertisingIncomeCnsldtSch) },
+	},
+	"AdvertisingIncomeExcessSchedule": {
+		"AdvertisingIncomeExcessSch": func() any { return new(AdvertisingIncomeExcessSchedule.AdvertisingIncomeExcessSch) },
+	},
+	"AffiliatedGroupFilingConsolidatedReturnStatement": {
+		"AffltGroupFilingCnsldtRetStmt": func() any { return new(AffiliatedGroupFilingConsolidatedReturnStatement.AffltGroupFilingCnsldtRetStmt) },
+	},
+	"AllocationAndCapitalizationMethodsStatement": {
+		"AllocnCapitalizationMthdStmt": func() any { return new(AllocationAndCapitalizationMethodsStatement.AllocnCapitalizationMthdStmt) },
+	},
+	"AmendedReturnChanges2": {
+		"AmendedReturnChanges2": func() any { return new(AmendedReturnChanges2.AmendedReturnChanges2) },
+	},
+	"AmortizationElectionStatement": {
+		"AmortizationElectionStatement": func() any { return new(AmortizationElectionStatement.AmortizationElectionStatement) },
+	},
+	"AppWithdrwNotPerfDndCnsntStmt": {
+		"AppWithdrwNotPerfDndCnsntStmt": func() any { return new(AppWithdrwNotPerfDndCnsntStmt.AppWithdrwNotPerfDndCnsntStmt) },
+	},
+	"AppealsFederalCourtExplanationStatement": {
+		"AppealsFederalCourtExplnStmt": func() any { return new(AppealsFederalCourtExplanationStatement.AppealsFederalCourtExplnStmt) },
+	},
+	"ApplcntNotRcvAudProtectionStmt": {
+		"ApplcntNotRcvAudProtectionStmt": func() any { return new(ApplcntNotRcvAudProtectionStmt.ApplcntNotRcvAudProtectionStmt) },
+	},
+	"ApplicantEligibleToChangeMethodOfAccountingStatement": {
+		"ApplicantEligChgMthdAcctStmt": func() any {
+			return new(ApplicantEligibleToChangeMethodOfAccountingStatement.ApplicantEligChgMthdAcctStmt)
+		},
+	},
+	"ApplicantReceivedAuditProtectionForRequestedChangeStatement": {
+		"ApplcntRcvdAudProtectionStmt": func() any {
+			return new(ApplicantReceivedAuditProtectionForRequestedChangeStatement.ApplcntRcvdAudProtectionStmt)
+		},
+	},
+	"ApplicantsContractsStatement": {
+		"ApplicantsContractsStatement": func() any { return new(ApplicantsContractsStatement.ApplicantsContractsStatement) },
+	},
+	"ApplicantsReasonForProposedChangeStatement": {
+		"ApplicantsRsnProposedChgStmt": func() any { return new(ApplicantsReasonForProposedChangeStatement.ApplicantsRsnProposedChgStmt) },
+	},
+	"AverageAcquisitionDebtFinancedPropertySchedule": {
+		"AvgAcquisDebtFincdPropSch": func() any { return new(AverageAcquisitionDebtFinancedPropertySchedule.AvgAcquisDebtFincdPropSch) },
+	},
+	"BasisForEntitlementStatement": {
+		"BasisForEntitlementStatement": func() any { return new(BasisForEntitlementStatement.BasisForEntitlementStatement) },
+	},
+	"BasisOtherThanActualCostOfPropertyStatement": {
+		"BasisOthThanActlCostPropStmt": func() any { return new(BasisOtherThanActualCostOfPropertyStatement.BasisOthThanActlCostPropStmt) },
+	},
+	"BinaryAttachment": {
+		"BinaryAttachment": func() any { return new(BinaryAttachment.BinaryAttachment) },
+	},
+	"BiodieselResellerStatement": {
+		"BiodieselResellerStatement": func() any { return new(BiodieselResellerStatement.BiodieselResellerStatement) },
+	},
+	"BusDisqualifiesAutoCnsntStmt": {
+		"BusDisqualifiesAutoCnsntStmt": func() any { return new(BusDisqualifiesAutoCnsntStmt.BusDisqualifiesAutoCnsntStmt) },
+	},
+	"CarryforwardGeneralBusinessCr": {
+		"CarryforwardGeneralBusinessCr": func() any { return new(CarryforwardGeneralBusinessCr.CarryforwardGeneralBusinessCr) },
+	},
+	"ChangeFromLIFOToNonLIFOMethodStatement": {
+		"ChangeLIFOToNonLIFOMethodStmt": func() any { return new(ChangeFromLIFOToNonLIFOMethodStatement.ChangeLifotoNonLifomethodStmt) },
+	},
+	"ChangeInAccountingMethodOrPeriodForPast5YearsStatement": {
+		"ChgInAcctMthdOrPrdPast5YrsStmt": func() any {
+			return new(ChangeInAccountingMethodOrPeriodForPast5YearsStatement.ChgInAcctMthdOrPrdPast5YrsStmt)
+		},
+	},
+	"ChangeInOverallMethodBreakdownStatement": {
+		"ChgInOverallMthdBreakdownStmt": func() any { return new(ChangeInOverallMethodBreakdownStatement.ChgInOverallMthdBreakdownStmt) },
+	},
+	"ChangeInOverallMethodOfAccountingStatement": {
+		"ChangeInOverallMthdOfAcctStmt": func() any { return new(ChangeInOverallMethodOfAccountingStatement.ChangeInOverallMthdOfAcctStmt) },
+	},
+	"ChangeInValuingInventoriesAdditionalInformationStatement": {
+		"ChangeInValuingInventoriesStmt": func() any {
+			return new(ChangeInValuingInventoriesAdditionalInformationStatement.ChangeInValuingInventoriesStmt)
+		},
+	},
+	"ChangeToCashMethodStatement": {
+		"ChangeToCashMethodStatement": func() any { return new(ChangeToCashMethodStatement.ChangeToCashMethodStatement) },
+	},
+	"ChangeToIPICMethodStatement": {
+		"ChangeToIPICMethodStatement": func() any { return new(ChangeToIPICMethodStatement.ChangeToIpicmethodStatement) },
+	},
+	"CharitableContributionSchedule": {
+		"CharitableContriSchedule": func() any { return new(CharitableContributionSchedule.CharitableContriSchedule) },
+	},
+	"CharitableContributionSchedule2": {
+		"CharitableContriSchedule2": func() any { return new(CharitableContributionSchedule2.CharitableContriSchedule2) },
+	},
+	"CharitableContributionStatement2": {
+		"CharitableContriStatement2": func() any { return new(CharitableContributionStatement2.CharitableContriStatement2) },
+	},
+	"CodeSectUnderWhichPropIsDeprecOrAmortzStatement": {
+		"CodeSectPropDeprecOrAmortzStmt": func() any { return new(CodeSectUnderWhichPropIsDeprecOrAmortzStatement.CodeSectPropDeprecOrAmortzStmt) },
+	},
+	"ComputationOfMinimumTaxCreditStmt": {
+		"ComputationOfMinTaxCrStmt": func() any { return new(ComputationOfMinimumTaxCreditStmt.ComputationOfMinTaxCrStmt) },
+	},
+	"ComputationOfSection481aAdjustmentStatement": {
+		"ComputationOfSect481aAdjStmt": func() any { return new(ComputationOfSection481aAdjustmentStatement.ComputationOfSect481AAdjStmt) },
+	},
+	"ConsolidatedGroupInformationStatement": {
+		"ConsolidatedGroupInfoStmt": func() any { return new(ConsolidatedGroupInformationStatement.ConsolidatedGroupInfoStmt) },
+	},
+	"ControlledForeignPartnershipReportingStatement": {
+		"ControlledForeignPrtshpStmt": func() any { return new(ControlledForeignPartnershipReportingStatement.ControlledForeignPrtshpStmt) },
+	},
+	"ControlledGroupMemberStatement": {
+		"ControlledGroupMemberStatement": func() any { return new(ControlledGroupMemberStatement.ControlledGroupMemberStatement) },
+	},
+	"ControlledGroupMembersStatement": {
+		"ControlledGroupMembersStmt": func() any { return new(ControlledGroupMembersStatement.ControlledGroupMembersStmt) },
+	},
+	"CostComparisonOrMethodUsedStatement": {
+		"CostComparisonOrMethodUsedStmt": func() any { return new(CostComparisonOrMethodUsedStatement.CostComparisonOrMethodUsedStmt) },
+	},
+	"CostGoodSoldOtherCostSchedule": {
+		"CostGoodSoldOtherCostSchedule": func() any { return new(CostGoodSoldOtherCostSchedule.CostGoodSoldOtherCostSchedule) },
+	},
+	"CostOtherThanActualCashCostStatement": {
+		"CostOthThanActualCashCostStmt": func() any { return new(CostOtherThanActualCashCostStatement.CostOthThanActualCashCostStmt) },
+	},
+	"CreditsRelatedToOtherRentalActivitiesStatement": {
+		"CrRelatedToOtherRentalActyStmt": func() any { return new(CreditsRelatedToOtherRentalActivitiesStatement.CrRelatedToOtherRentalActyStmt) },
+	},
+	"CreditsRelatedToRentalREActivitiesStatement": {
+		"CreditsRltdToRentalREActyStmt": func() any { return new(CreditsRelatedToRentalREActivitiesStatement.CreditsRltdToRentalReactyStmt) },
+	},
+	"CurrencyConversionStatement": {
+		"CurrencyConversionStatement": func() any { return new(CurrencyConversionStatement.CurrencyConversionStatement) },
+	},
+	"DebtFinancedExpenseSchedule": {
+		"DebtFinancedExpenseSchedule": func() any { return new(DebtFinancedExpenseSchedule.DebtFinancedExpenseSchedule) },
+	},
+	"DeductionsConnectedRentalIncomeSchedule": {
+		"DeductionsConnectedRntlIncmSch": func() any { return new(DeductionsConnectedRentalIncomeSchedule.DeductionsConnectedRntlIncmSch) },
+	},
+	"DeductionsOtherCategoriesSchedule": {
+		"DedOtherCategoriesSchedule": func() any { return new(DeductionsOtherCategoriesSchedule.DedOtherCategoriesSchedule) },
+	},
+	"DeferralMethodAdvancePayments": {
+		"DeferralMethodAdvancePayments": func() any { return new(DeferralMethodAdvancePayments.DeferralMethodAdvancePayments) },
+	},
+	"DescriptionOfInventoryGoodsBeingChangedStatement": {
+		"DescOfInvntryGoodsChangedStmt": func() any { return new(DescriptionOfInventoryGoodsBeingChangedStatement.DescOfInvntryGoodsChangedStmt) },
+	},
+	"DescriptionOfInventoryGoodsNotBeingChangedStatement": {
+		"DescOfInvntryGoodsNotChgdStmt": func() any {
+			return new(DescriptionOfInventoryGoodsNotBeingChangedStatement.DescOfInvntryGoodsNotChgdStmt)
+		},
+	},
+	"DescriptionOfPropertyBeingChangedStatement": {
+		"DescOfPropertyBeingChangedStmt": func() any { return new(DescriptionOfPropertyBeingChangedStatement.DescOfPropertyBeingChangedStmt) },
+	},
+	"DieselWaterFuelEmulsionBlendingStatement": {
+		"DslWaterFuelEmulsionBlndgStmt": func() any { return new(DieselWaterFuelEmulsionBlendingStatement.DslWaterFuelEmulsionBlndgStmt) },
+	},
+	"DispositionOfPropWithSect179DeductionsStatement": {
+		"DisposOfPropWithSect179DedStmt": func() any { return new(DispositionOfPropWithSect179DeductionsStatement.DisposOfPropWithSect179DedStmt) },
+	},
+	"DistributionsOfMoneyStatement": {
+		"DistributionsOfMoneyStatement": func() any { return new(DistributionsOfMoneyStatement.DistributionsOfMoneyStatement) },
+	},
+	"DistributionsOfPropertyOtherThanMoneyStatement": {
+		"DistriOfPropOtherThanMoneyStmt": func() any { return new(DistributionsOfPropertyOtherThanMoneyStatement.DistriOfPropOtherThanMoneyStmt) },
+	},
+	"EarningsAndProfitsSchedule": {
+		"EarningsAndProfitsSchedule": func() any { return new(EarningsAndProfitsSchedule.EarningsAndProfitsSchedule) },
+	},
+	"EvidenceOfDyedDieselFuelSoldStatement": {
+		"EvidenceDyedDieselFuelSoldStmt": func() any { return new(EvidenceOfDyedDieselFuelSoldStatement.EvidenceDyedDieselFuelSoldStmt) },
+	},
+	"EvidenceOfDyedDieselFuelStatement": {
+		"EvidenceOfDyedDieselFuelStmt": func() any { return new(EvidenceOfDyedDieselFuelStatement.EvidenceOfDyedDieselFuelStmt) },
+	},
+	"EvidenceOfDyedKeroseneSoldStatement": {
+		"EvidenceOfDyedKeroseneSoldStmt": func() any { return new(EvidenceOfDyedKeroseneSoldStatement.EvidenceOfDyedKeroseneSoldStmt) },
+	},
+	"EvidenceOfDyedKeroseneStatement": {
+		"EvidenceOfDyedKeroseneStmt": func() any { return new(EvidenceOfDyedKeroseneStatement.EvidenceOfDyedKeroseneStmt) },
+	},
+	"ExceptionUnderSection460eStatement": {
+		"ExceptionUnderSection460eStmt": func() any { return new(ExceptionUnderSection460eStatement.ExceptionUnderSection460EStmt) },
+	},
+	"ExpenseConnectedInvestmentIncome501c7917Schedule": {
+		"ExpnsCnnctInvstIncm501c7917Sch": func() any {
+			return new(ExpenseConnectedInvestmentIncome501c7917Schedule.ExpnsCnnctInvstIncm501C7917Sch)
+		},
+	},
+	"ExpensesFromOtherRentalActivitiesSchedule": {
+		"ExpensesOtherRentalActySch": func() any { return new(ExpensesFromOtherRentalActivitiesSchedule.ExpensesOtherRentalActySch) },
+	},
+	"ExplanationHowPropertyTreatedUnderPresentMethodStatement": {
+		"ExplnPropTrtdUndPresMthdStmt": func() any {
+			return new(ExplanationHowPropertyTreatedUnderPresentMethodStatement.ExplnPropTrtdUndPresMthdStmt)
+		},
+	},
+	"ExploitedActivityNotUnrelatedBusinessIncomeSchedule": {
+		"ExploitedActivityNotUBISch": func() any { return new(ExploitedActivityNotUnrelatedBusinessIncomeSchedule.ExploitedActivityNotUbisch) },
+	},
+	"ExploitedActivityUnrelatedBusinessIncomeSchedule": {
+		"ExploitedActivityUBISch": func() any { return new(ExploitedActivityUnrelatedBusinessIncomeSchedule.ExploitedActivityUbisch) },
+	},
+	"FactsSuprtProposedChgToDepreciateOrAmortizePropStmt": {
+		"ChgToDepreciateAmortzPropStmt": func() any {
+			return new(FactsSuprtProposedChgToDepreciateOrAmortizePropStmt.ChgToDepreciateAmortzPropStmt)
+		},
+	},
+	"FinancialServicesIncomeStatement": {
+		"FinancialServicesIncomeStmt": func() any { return new(FinancialServicesIncomeStatement.FinancialServicesIncomeStmt) },
+	},
+	"ForeignBranchIncomeStatement": {
+		"ForeignBranchIncomeStatement": func() any { return new(ForeignBranchIncomeStatement.ForeignBranchIncomeStatement) },
+	},
+	"ForeignGrossIncomeAtCorpLevelOtherCatSchedule": {
+		"FrgnGroIncmCorpLvlOtherCatSch": func() any { return new(ForeignGrossIncomeAtCorpLevelOtherCatSchedule.FrgnGroIncmCorpLvlOtherCatSch) },
+	},
+	"ForeignGrossIncomeAtPartnershipLevelOtherCategoriesSchedule": {
+		"FrgnGrossAtPrtshpLvlOthCatSch": func() any {
+			return new(ForeignGrossIncomeAtPartnershipLevelOtherCategoriesSchedule.FrgnGrossAtPrtshpLvlOthCatSch)
+		},
+	},
+	"ForeignTaxSchedule": {
+		"DedAllocApprtnCorpLvlOtherAmt":  func() any { return new(ForeignTaxSchedule.ForeignTaxInformationTypDedAllocApprtnCorpLvlOtherAmt) },
+		"ForeignTaxSchedule":             func() any { return new(ForeignTaxSchedule.ForeignTaxSchedule) },
+		"FrgnGroIncmSrcdCorpLvlOtherAmt": func() any { return new(ForeignTaxSchedule.ForeignTaxInformationTypFrgnGroIncmSrcdCorpLvlOtherAmt) },
+		"GrossIncmSrcdAtShrLvlAmt":       func() any { return new(ForeignTaxSchedule.ForeignTaxInformationTypGrossIncmSrcdAtShrLvlAmt) },
+		"TaxReductionAvailableForCrAmt":  func() any { return new(ForeignTaxSchedule.ForeignTaxInformationTypTaxReductionAvailableForCrAmt) },
+	},
+	"ForeignTaxesPaidAccruedAndDeemedPaidStatement": {
+		"FrgnTaxesPdAccrAndDeemedPdStmt": func() any { return new(ForeignTaxesPaidAccruedAndDeemedPaidStatement.FrgnTaxesPdAccrAndDeemedPdStmt) },
+	},
+	"ForeignTransactionStatement": {
+		"ForeignTransactionStatement": func() any { return new(ForeignTransactionStatement.ForeignTransactionStatement) },
+	},
+	"GenBusinessCreditComputation": {
+		"GenBusinessCreditComputation": func() any { return new(GenBusinessCreditComputation.GenBusinessCreditComputation) },
+	},
+	"GeneralDependency": {
+		"GeneralDependency": func() any { return new(GeneralDependency.GeneralDependency) },
+	},
+	"GeneralDependencyMedium": {
+		"GeneralDependencyMedium": func() any { return new(GeneralDependencyMedium.GeneralDependencyMedium) },
+	},
+	"GeneralDependencySmall": {
+		"GeneralDependencySmall": func() any { return new(GeneralDependencySmall.GeneralDependencySmall) },
+	},
+	"GrossIncomeSourcedAtShareholderLevelSchedule": {
+		"GrossIncmSourcedAtShrLevelSch": func() any { return new(GrossIncomeSourcedAtShareholderLevelSchedule.GrossIncmSourcedAtShrLevelSch) },
+	},
+	"GrossReceiptsInstallmentSalesSchedule": {
+		"GrossReceiptsInstalSalesSch": func() any { return new(GrossReceiptsInstallmentSalesSchedule.GrossReceiptsInstalSalesSch) },
+	},
+	"IRS1041ScheduleD": {
+		"IRS1041ScheduleD": func() any { return new(IRS1041ScheduleD.Irs1041ScheduleD) },
+	},
+	"IRS1041ScheduleI": {
+		"IRS1041ScheduleI": func() any { return new(IRS1041ScheduleI.Irs1041ScheduleI) },
+	},
+	"IRS1120ScheduleD": {
+		"IRS1120ScheduleD": func() any { return new(IRS1120ScheduleD.Irs1120ScheduleD) },
+	},
+	"IRS1122": {
+		"IRS1122": func() any { return new(IRS1122.Irs1122) },
+	},
+	"IRS2439": {
+		"IRS2439": func() any { return new(IRS2439.Irs2439) },
+	},
+	"IRS3115": {
+		"ExemptOrganizationInd": func() any { return new(IRS3115.WhoIsFilingFormExemptOrganizationInd) },
+		"IRS3115":               func() any { return new(IRS3115.Irs3115) },
+		"OtherApplicantTypeInd": func() any { return new(IRS3115.TypeOfAccountingMethodOtherApplicantTypeInd) },
+	},
+	"IRS3800": {
+		"Frm3468PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartIicyaggrgtAmtGrp) },
+		"Frm3468PartIIICYAggrgtAmtGrp":   func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartIiicyaggrgtAmtGrp) },
+		"Frm3468PartIVCYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartIvcyaggrgtAmtGrp) },
+		"Frm3468PartVCYAggrgtAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartVcyaggrgtAmtGrp) },
+		"Frm3468PartVICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartVicyaggrgtAmtGrp) },
+		"Frm3468PartVIICYAggrgtAmtGrp":   func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm3468PartViicyaggrgtAmtGrp) },
+		"Frm5884CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm5884CyaggrgtAmtGrp) },
+		"Frm6478CYSpcfdCrAggrgtGrp":      func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm6478CyspcfdCrAggrgtGrp) },
+		"Frm6765CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm6765CyaggrgtAmtGrp) },
+		"Frm6765ESBCYAggrgtAmtGrp":       func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm6765EsbcyaggrgtAmtGrp) },
+		"Frm7207CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7207CyaggrgtAmtGrp) },
+		"Frm7210CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7210CyaggrgtAmtGrp) },
+		"Frm7211PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7211PartIicyaggrgtAmtGrp) },
+		"Frm7213PartICYAggrgtAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7213PartIcyaggrgtAmtGrp) },
+		"Frm7213PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7213PartIicyaggrgtAmtGrp) },
+		"Frm7218PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm7218PartIicyaggrgtAmtGrp) },
+		"Frm8586CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8586CyaggrgtAmtGrp) },
+		"Frm8820CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8820CyaggrgtAmtGrp) },
+		"Frm8826CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8826CyaggrgtAmtGrp) },
+		"Frm8830CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8830CyaggrgtAmtGrp) },
+		"Frm8835PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyaggrgtAmtGrp) },
+		"Frm8835PartIICYSpcfdAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8835PartIicyspcfdAmtGrp) },
+		"Frm8844CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8844CyaggrgtAmtGrp) },
+		"Frm8846CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8846CyaggrgtAmtGrp) },
+		"Frm8864CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8864CyaggrgtAmtGrp) },
+		"Frm8864SAFCYAggrgtAmtGrp":       func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8864SafcyaggrgtAmtGrp) },
+		"Frm8874CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8874CyaggrgtAmtGrp) },
+		"Frm8881PartICYAggrgtAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8881PartIcyaggrgtAmtGrp) },
+		"Frm8881PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8881PartIicyaggrgtAmtGrp) },
+		"Frm8881PartIIICYAggrgtAmtGrp":   func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8881PartIiicyaggrgtAmtGrp) },
+		"Frm8882CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8882CyaggrgtAmtGrp) },
+		"Frm8896CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8896CyaggrgtAmtGrp) },
+		"Frm8900CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8900CyaggrgtAmtGrp) },
+		"Frm8904CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8904CyaggrgtAmtGrp) },
+		"Frm8906CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8906CyaggrgtAmtGrp) },
+		"Frm8908CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8908CyaggrgtAmtGrp) },
+		"Frm8911PartICYAggrgtAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8911PartIcyaggrgtAmtGrp) },
+		"Frm8932CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8932CyaggrgtAmtGrp) },
+		"Frm8933CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8933CyaggrgtAmtGrp) },
+		"Frm8936PartIICYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8936PartIicyaggrgtAmtGrp) },
+		"Frm8936PartVCYAggrgtAmtGrp":     func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8936PartVcyaggrgtAmtGrp) },
+		"Frm8941CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8941CyaggrgtAmtGrp) },
+		"Frm8994CYAggrgtAmtGrp":          func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpFrm8994CyaggrgtAmtGrp) },
+		"GenBusCYAggrgtSubTot2Grp":       func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTot2Grp) },
+		"GenBusCYAggrgtSubTotGrp":        func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpGenBusCyaggrgtSubTotGrp) },
+		"GenBusOthSpcfdCrCYAggrgtAmtGrp": func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpGenBusOthSpcfdCrCyaggrgtAmtGrp) },
+		"GenBusOtherCrCYAggrgtAmtGrp":    func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpGenBusOtherCrCyaggrgtAmtGrp) },
+		"IRS3800":                        func() any { return new(IRS3800.Irs3800) },
+		"TotGenBusCYAggrgtAmtGrp":        func() any { return new(IRS3800.GbcbreakdownCyaggrgtAmtGrpTotGenBusCyaggrgtAmtGrp) },
+	},
+	"IRS4136": {
+		"IRS4136": func() any { return new(IRS4136.Irs4136) },
+	},
+	"IRS4562": {
+		"ADS12YearProperty":            func() any { return new(IRS4562.AlternativeDepreciationSystemAds12YearProperty) },
+		"ADS30YearProperty":            func() any { return new(IRS4562.AlternativeDepreciationSystemAds30YearProperty) },
+		"ADS40YearProperty":            func() any { return new(IRS4562.AlternativeDepreciationSystemAds40YearProperty) },
+		"ADSClassLifeProperty":         func() any { return new(IRS4562.AlternativeDepreciationSystemAdsclassLifeProperty) },
+		"GDS25YearProperty":            func() any { return new(IRS4562.GeneralDepreciationSystemGds25YearProperty) },
+		"GDSNonRsdntlRealProp":         func() any { return new(IRS4562.GeneralDepreciationSystemGdsnonRsdntlRealProp) },
+		"GDSNonRsdntlRealPropSpecify":  func() any { return new(IRS4562.GeneralDepreciationSystemGdsnonRsdntlRealPropSpecify) },
+		"GDSResidentialRentalProperty": func() any { return new(IRS4562.GeneralDepreciationSystemGdsresidentialRentalProperty) },
+		"IRS4562":                      func() any { return new(IRS4562.Irs4562) },
+	},
+	"IRS8801": {
+		"IRS8801": func() any { return new(IRS8801.Irs8801) },
+	},
+	"IRS8827": {
+		"IRS8827": func() any { return new(IRS8827.Irs8827) },
+	},
+	"IRS8834": {
+		"IRS8834": func() any { return new(IRS8834.Irs8834) },
+	},
+	"IRS8873": {
+		"IRS8873": func() any { return new(IRS8873.Irs8873) },
+	},
+	"IRS8902": {
+		"IRS8902": func() any { return new(IRS8902.Irs8902) },
+	},
+	"IRS8912": {
+		"BondNotRptOn1097BTCDetail": func() any { return new(IRS8912.BondNotOnForm1097BtcgrpBondNotRptOn1097Btcdetail) },
+		"IRS8912":                   func() any { return new(IRS8912.Irs8912) },
+	},
+	"IRS8949": {
+		"IRS8949": func() any { return new(IRS8949.Irs8949) },
+	},
+	"IRS990N": {
+		"IRS990N": func() any { return new(IRS990N.Irs990N) },
+	},
+	"IRS990T": {
+		"IRS990T": func() any { return new(IRS990T.Irs990T) },
+	},
+	"IRS990TScheduleA": {
+		"ConsolidatedPeriodicalInd": func() any { return new(IRS990TScheduleA.AdvertisingIncmPeriodicalGrpConsolidatedPeriodicalInd) },
+		"IRS990TScheduleA":          func() any { return new(IRS990TScheduleA.Irs990TscheduleA) },
+	},
+	"IRSESPayment": {
+		"IRSESPayment": func() any { return new(IRSESPayment.Irsespayment) },
+	},
+	"IRSPayment": {
+		"IRSPayment": func() any { return new(IRSPayment.Irspayment) },
+	},
+	"IncomeExpensesOtherPassiveRentalActivitiesStatement": {
+		"IncmExpnssOthPssvRntlActyStmt": func() any {
+			return new(IncomeExpensesOtherPassiveRentalActivitiesStatement.IncmExpnssOthPssvRntlActyStmt)
+		},
+	},
+	"IncomeLossPartnershipSCorporationSchedule": {
+		"IncomeLossPartnershipSCorpSch": func() any { return new(IncomeLossPartnershipSCorporationSchedule.IncomeLossPartnershipScorpSch) },
+	},
+	"IncomeReceivedOrReportedBeforeEarnedStatement": {
+		"IncmRcvdOrRptBeforeEarnedStmt": func() any { return new(IncomeReceivedOrReportedBeforeEarnedStatement.IncmRcvdOrRptBeforeEarnedStmt) },
+	},
+	"IncomeTaxReturnsStatement": {
+		"IncomeTaxReturnsStatement": func() any { return new(IncomeTaxReturnsStatement.IncomeTaxReturnsStatement) },
+	},
+	"InterestSchedule": {
+		"InterestSchedule": func() any { return new(InterestSchedule.InterestSchedule) },
+	},
+	"InvntryValuationMthdStatement": {
+		"InvntryValuationMthdStatement": func() any { return new(InvntryValuationMthdStatement.InvntryValuationMthdStatement) },
+	},
+	"ItemizedDeductionsNotChargedAgainstBooksSchedule2": {
+		"ItemizedDedNotChargedBooksSch2": func() any {
+			return new(ItemizedDeductionsNotChargedAgainstBooksSchedule2.ItemizedDedNotChargedBooksSch2)
+		},
+	},
+	"ItemizedDeductionsPortfolioIncomeLossStatement": {
+		"ItemizedDedPrtflIncomeLossStmt": func() any { return new(ItemizedDeductionsPortfolioIncomeLossStatement.ItemizedDedPrtflIncomeLossStmt) },
+	},
+	"ItemizedExpensesRecordedOnBooksSchedule2": {
+		"ItemizedExpensesRecOnBooksSch2": func() any { return new(ItemizedExpensesRecordedOnBooksSchedule2.ItemizedExpensesRecOnBooksSch2) },
+	},
+	"ItemizedIncomeNotRecordedOnBooksSchedule2": {
+		"ItemizedIncmNotRecOnBooksSch2": func() any { return new(ItemizedIncomeNotRecordedOnBooksSchedule2.ItemizedIncmNotRecOnBooksSch2) },
+	},
+	"ItemizedIncomeRecordedOnBooksSchedule2": {
+		"ItemizedIncomeRecOnBooksSch2": func() any { return new(ItemizedIncomeRecordedOnBooksSchedule2.ItemizedIncomeRecOnBooksSch2) },
+	},
+	"ItemizedOtherAssetsSchedule": {
+		"ItemizedOtherAssetsSchedule": func() any { return new(ItemizedOtherAssetsSchedule.ItemizedOtherAssetsSchedule) },
+	},
+	"ItemizedOtherCreditsSchedule": {
+		"ItemizedOtherCreditsSchedule": func() any { return new(ItemizedOtherCreditsSchedule.ItemizedOtherCreditsSchedule) },
+	},
+	"ItemizedOtherCurrentAssetsSchedule": {
+		"ItemizedOtherCurrentAssetsSch": func() any { return new(ItemizedOtherCurrentAssetsSchedule.ItemizedOtherCurrentAssetsSch) },
+	},
+	"ItemizedOtherCurrentLiabilitiesSchedule": {
+		"ItemizedOthCurrLiabilitiesSch": func() any { return new(ItemizedOtherCurrentLiabilitiesSchedule.ItemizedOthCurrLiabilitiesSch) },
+	},
+	"ItemizedOtherDeductionsSchedule2": {
+		"ItemizedOtherDeductionSch2": func() any { return new(ItemizedOtherDeductionsSchedule2.ItemizedOtherDeductionSch2) },
+	},
+	"ItemizedOtherDeductionsSchedule3": {
+		"ItemizedOtherDeductionSch3": func() any { return new(ItemizedOtherDeductionsSchedule3.ItemizedOtherDeductionSch3) },
+	},
+	"ItemizedOtherIncomeLossSchedule": {
+		"ItemizedOtherIncomeLossSch": func() any { return new(ItemizedOtherIncomeLossSchedule.ItemizedOtherIncomeLossSch) },
+	},
+	"ItemizedOtherInvestmentsSchedule": {
+		"ItemizedOtherInvestmentsSch": func() any { return new(ItemizedOtherInvestmentsSchedule.ItemizedOtherInvestmentsSch) },
+	},
+	"ItemizedOtherLiabilitiesSchedule": {
+		"ItemizedOtherLiabilitiesSch": func() any { return new(ItemizedOtherLiabilitiesSchedule.ItemizedOtherLiabilitiesSch) },
+	},
+	"ItemizedTotalForeignTaxesSchedule": {
+		"ItemizedTotalForeignTaxesSch": func() any { return new(ItemizedTotalForeignTaxesSchedule.ItemizedTotalForeignTaxesSch) },
+	},
+	"LIFOInventoryOtherThanCostStatement": {
+		"LIFOInventoryOtherThanCostStmt": func() any { return new(LIFOInventoryOtherThanCostStatement.LifoinventoryOtherThanCostStmt) },
+	},
+	"LegalBasisForChangeStatement": {
+		"LegalBasisForChangeStatement": func() any { return new(LegalBasisForChangeStatement.LegalBasisForChangeStatement) },
+	},
+	"ListOfOtherCostsDirectAndIndirectCostsAttachment": {
+		"OtherDirectAndIndirectCostsAtt": func() any {
+			return new(ListOfOtherCostsDirectAndIndirectCostsAttachment.OtherDirectAndIndirectCostsAtt)
+		},
+	},
+	"LocalUnitSpecificDeductionSchedule": {
+		"LocalUnitSpecificDeductionSch": func() any { return new(LocalUnitSpecificDeductionSchedule.LocalUnitSpecificDeductionSch) },
+	},
+	"LongTermContractsStatement": {
+		"LongTermContractsStatement": func() any { return new(LongTermContractsStatement.LongTermContractsStatement) },
+	},
+	"LongTermManufacturingContractsStatement": {
+		"LTManufacturingContractsStmt": func() any { return new(LongTermManufacturingContractsStatement.LtmanufacturingContractsStmt) },
+	},
+	"LowIncomeHousingCreditStatement": {
+		"LowIncomeHousingCreditStmt": func() any { return new(LowIncomeHousingCreditStatement.LowIncomeHousingCreditStmt) },
+	},
+	"ManufacturingProposedPoolStatement": {
+		"ManufacturingProposedPoolStmt": func() any { return new(ManufacturingProposedPoolStatement.ManufacturingProposedPoolStmt) },
+	},
+	"MfrGoodsSoldOrDistributedStatement": {
+		"MfrGoodsSoldOrDistributedStmt": func() any { return new(MfrGoodsSoldOrDistributedStatement.MfrGoodsSoldOrDistributedStmt) },
+	},
+	"MixedStraddleAccountElectionStatement": {
+		"MixedStraddleAcctElectionStmt": func() any { return new(MixedStraddleAccountElectionStatement.MixedStraddleAcctElectionStmt) },
+	},
+	"ModelOAndModelSCertificateStatement": {
+		"ModelOModelSCertificateStmt": func() any { return new(ModelOAndModelSCertificateStatement.ModelOmodelScertificateStmt) },
+	},
+	"MthdOfCostAllocationCostsNotFullyUnderSect263Aor460Stmt": {
+		"MthdAllocnNotSect263Aor460Stmt": func() any {
+			return new(MthdOfCostAllocationCostsNotFullyUnderSect263Aor460Stmt.MthdAllocnNotSect263Aor460Stmt)
+		},
+	},
+	"NetIncomeLossAtRiskREActySchedule": {
+		"NetIncomeLossAtRiskREActySch": func() any { return new(NetIncomeLossAtRiskREActySchedule.NetIncomeLossAtRiskReactySch) },
+	},
+	"NonAutomaticChangeRequestProceduresStatement": {
+		"NonAutomaticChgReqProcStmt": func() any { return new(NonAutomaticChangeRequestProceduresStatement.NonAutomaticChgReqProcStmt) },
+	},
+	"NonaccrualExperienceMethodSchedule2": {
+		"NonaccrualExperienceMethodSch2": func() any { return new(NonaccrualExperienceMethodSchedule2.NonaccrualExperienceMethodSch2) },
+	},
+	"NonconventionalSourceFuelCreditSchedule": {
+		"NonconventionalSourceFuelCrSch": func() any { return new(NonconventionalSourceFuelCreditSchedule.NonconventionalSourceFuelCrSch) },
+	},
+	"NontaxableUseFuelsCreditCardUsersStatement": {
+		"NontxUseFuelsCrCardUsersStmt": func() any { return new(NontaxableUseFuelsCreditCardUsersStatement.NontxUseFuelsCrCardUsersStmt) },
+	},
+	"OpeningInventoryAdjustmentStatement": {
+		"OpeningInventoryAdjustmentStmt": func() any { return new(OpeningInventoryAdjustmentStatement.OpeningInventoryAdjustmentStmt) },
+	},
+	"OrganizationChartStatement": {
+		"OrganizationChartStatement": func() any { return new(OrganizationChartStatement.OrganizationChartStatement) },
+	},
+	"OtherAdjustmentsAndTaxPreferenceItemsSchedule": {
+		"OtherAdjAndTaxPrefItemsSch": func() any { return new(OtherAdjustmentsAndTaxPreferenceItemsSchedule.OtherAdjAndTaxPrefItemsSch) },
+	},
+	"OtherAmountsStatement": {
+		"OtherAmountsStatement": func() any { return new(OtherAmountsStatement.OtherAmountsStatement) },
+	},
+	"OtherCostsNotRequiredToBeAllocatedAttachment": {
+		"OtherCostsNotReqrToBeAllocAtt": func() any { return new(OtherCostsNotRequiredToBeAllocatedAttachment.OtherCostsNotReqrToBeAllocAtt) },
+	},
+	"OtherCostsUnderCostofGoodsSoldSchedule": {
+		"OtherCostsUnderGoodsSoldSch": func() any { return new(OtherCostsUnderCostofGoodsSoldSchedule.OtherCostsUnderGoodsSoldSch) },
+	},
+	"OtherCreditPaymentSchedule": {
+		"OtherCreditPaymentSchedule": func() any { return new(OtherCreditPaymentSchedule.OtherCreditPaymentSchedule) },
+	},
+	"OtherCreditsSchedule2": {
+		"OtherCreditsSchedule2": func() any { return new(OtherCreditsSchedule2.OtherCreditsSchedule2) },
+	},
+	"OtherDeductionSchedule": {
+		"OtherDeductionSchedule": func() any { return new(OtherDeductionSchedule.OtherDeductionSchedule) },
+	},
+	"OtherExpensesAndDeductionsSchedule": {
+		"OtherExpensesandDeductionsSch": func() any { return new(OtherExpensesAndDeductionsSchedule.OtherExpensesandDeductionsSch) },
+	},
+	"OtherIdentificationAndValuationMethodsStatement": {
+		"OtherIdAndValuationMethodsStmt": func() any { return new(OtherIdentificationAndValuationMethodsStatement.OtherIdAndValuationMethodsStmt) },
+	},
+	"OtherIncomeSchedule3": {
+		"OtherIncomeSchedule3": func() any { return new(OtherIncomeSchedule3.OtherIncomeSchedule3) },
+	},
+	"OtherIncomeStatement": {
+		"OtherIncomeStatement": func() any { return new(OtherIncomeStatement.OtherIncomeStatement) },
+	},
+	"OtherItemsAndAmountsSchedule": {
+		"OtherItemsAndAmountsSchedule": func() any { return new(OtherItemsAndAmountsSchedule.OtherItemsAndAmountsSchedule) },
+	},
+	"OtherPortfolioIncomeLossStatement": {
+		"OtherPortfolioIncomeLossStmt": func() any { return new(OtherPortfolioIncomeLossStatement.OtherPortfolioIncomeLossStmt) },
+	},
+	"OtherRecaptureCreditsSchedule": {
+		"OtherRecaptureCreditsSchedule": func() any { return new(OtherRecaptureCreditsSchedule.OtherRecaptureCreditsSchedule) },
+	},
+	"OtherReductionOfTaxesSchedule": {
+		"OtherReductionOfTaxesSchedule": func() any { return new(OtherReductionOfTaxesSchedule.OtherReductionOfTaxesSchedule) },
+	},
+	"OtherTaxAmountSchedule": {
+		"OtherTaxAmountSchedule": func() any { return new(OtherTaxAmountSchedule.OtherTaxAmountSchedule) },
+	},
+	"OtherTaxSchedule": {
+		"OtherTaxSchedule": func() any { return new(OtherTaxSchedule.OtherTaxSchedule) },
+	},
+	"OverallMethodOfAccountingAttachment": {
+		"OverallMethodOfAccountingAtt": func() any { return new(OverallMethodOfAccountingAttachment.OverallMethodOfAccountingAtt) },
+	},
+	"Owned10PercentInterestInForeignPartnershipStatement": {
+		"Owned10PctIntForeignPrtshpStmt": func() any {
+			return new(Owned10PercentInterestInForeignPartnershipStatement.Owned10PctIntForeignPrtshpStmt)
+		},
+	},
+	"ParentCorporationInformationStatement": {
+		"ParentCorpInformationStmt": func() any { return new(ParentCorporationInformationStatement.ParentCorpInformationStmt) },
+	},
+	"PassiveActivityOtherIncomeLossSchedule": {
+		"PssvActyOtherIncmLossSchedule": func() any { return new(PassiveActivityOtherIncomeLossSchedule.PssvActyOtherIncmLossSchedule) },
+	},
+	"PassiveActivitySection1231GainLossStatement": {
+		"PssvActySect1231GainLossStmt": func() any { return new(PassiveActivitySection1231GainLossStatement.PssvActySect1231GainLossStmt) },
+	},
+	"Post1986UndistributedEarningsSchedule": {
+		"Post1986UndistributedEarnSch": func() any { return new(Post1986UndistributedEarningsSchedule.Post1986UndistributedEarnSch) },
+	},
+	"Post2017NOLSchedule": {
+		"Post2017NOLSchedule": func() any { return new(Post2017NOLSchedule.Post2017Nolschedule) },
+	},
+	"Pre2018NOLSchedule": {
+		"Pre2018NOLSchedule": func() any { return new(Pre2018NOLSchedule.Pre2018Nolschedule) },
+	},
+	"PresentAndProposedLIFOMethodsStatment": {
+		"PresentAndProposedLIFOMthdStmt": func() any { return new(PresentAndProposedLIFOMethodsStatment.PresentAndProposedLifomthdStmt) },
+	},
+	"PresentHybridMethodOfAccountingAttachment": {
+		"PresentHybridMethodOfAcctAtt": func() any { return new(PresentHybridMethodOfAccountingAttachment.PresentHybridMethodOfAcctAtt) },
+	},
+	"PresentMethodDifferenceExplanationStatement": {
+		"PresentMethodDiffExplnStmt": func() any { return new(PresentMethodDifferenceExplanationStatement.PresentMethodDiffExplnStmt) },
+	},
+	"PrivateLetterRulingChgInAcctMthdTechAdviceReqStatement": {
+		"PrvtLtrRlngAcctMthdChgTechStmt": func() any {
+			return new(PrivateLetterRulingChgInAcctMthdTechAdviceReqStatement.PrvtLtrRlngAcctMthdChgTechStmt)
+		},
+	},
+	"ProposedChangeInPoolingInventoriesStatement": {
+		"PrpsdChgPoolingInventoriesStmt": func() any { return new(ProposedChangeInPoolingInventoriesStatement.PrpsdChgPoolingInventoriesStmt) },
+	},
+	"ProposedChangeNotForLIFOInventoryStatement": {
+		"PrpsdChgNotForLIFOInvntryStmt": func() any { return new(ProposedChangeNotForLIFOInventoryStatement.PrpsdChgNotForLifoinvntryStmt) },
+	},
+	"ProposedChangeNotForLIFOPoolsStatement": {
+		"PrpsdChgNotForLIFOPoolsStmt": func() any { return new(ProposedChangeNotForLIFOPoolsStatement.PrpsdChgNotForLifopoolsStmt) },
+	},
+	"ProposedHybridMethodOfAccountingAttachment": {
+		"ProposedHybridMethodOfAcctAtt": func() any { return new(ProposedHybridMethodOfAccountingAttachment.ProposedHybridMethodOfAcctAtt) },
+	},
+	"ProposedNaturalBusinessUnitStatement": {
+		"ProposedNaturalBusUnitStmt": func() any { return new(ProposedNaturalBusinessUnitStatement.ProposedNaturalBusUnitStmt) },
+	},
+	"ProxyTaxSchedule": {
+		"ProxyTaxSchedule": func() any { return new(ProxyTaxSchedule.ProxyTaxSchedule) },
+	},
+	"QualifiedRehabilitationExpendituresStatement": {
+		"QualifiedRehbltExpendStatement": func() any { return new(QualifiedRehabilitationExpendituresStatement.QualifiedRehbltExpendStatement) },
+	},
+	"QualifyingCoreSecondaryOrIncidentalActivitiesSchedule": {
+		"QlfyCoreSecOrIncidlActySch": func() any {
+			return new(QualifyingCoreSecondaryOrIncidentalActivitiesSchedule.QlfyCoreSecOrIncidlActySch)
+		},
+	},
+	"REMICStatement": {
+		"REMICStatement": func() any { return new(REMICStatement.Remicstatement) },
+	},
+	"ReasonableCauseExplanation": {
+		"ReasonableCauseExplanation": func() any { return new(ReasonableCauseExplanation.ReasonableCauseExplanation) },
+	},
+	"ReducedUserFeeStatement": {
+		"ReducedUserFeeStatement": func() any { return new(ReducedUserFeeStatement.ReducedUserFeeStatement) },
+	},
+	"ReductionInTaxesSchedule": {
+		"ReductionInTaxesSchedule": func() any { return new(ReductionInTaxesSchedule.ReductionInTaxesSchedule) },
+	},
+	"ReductionOfTaxesForSection6038cPenaltySchedule": {
+		"TaxRedForSect6038cPenaltySch": func() any { return new(ReductionOfTaxesForSection6038cPenaltySchedule.TaxRedForSect6038CPenaltySch) },
+	},
+	"ReductionOfTaxesUnderSection901eSchedule": {
+		"TaxReductionUnderSect901eSch": func() any { return new(ReductionOfTaxesUnderSection901eSchedule.TaxReductionUnderSect901ESch) },
+	},
+	"RequestToDeferAdvancePaymentUnderRegSect14515Stmt": {
+		"ReqToDeferUndRegSect14515Stmt": func() any {
+			return new(RequestToDeferAdvancePaymentUnderRegSect14515Stmt.ReqToDeferUndRegSect14515Stmt)
+		},
+	},
+	"RequireUseCutOffBasisStatement": {
+		"RequireUseCutOffBasisStatement": func() any { return new(RequireUseCutOffBasisStatement.RequireUseCutOffBasisStatement) },
+	},
+	"ReturnData990N": {
+		"ReturnData": func() any { return new(ReturnData990N.ReturnData) },
+	},
+	"ReturnHeader990N": {
+		"ReturnHeader": func() any { return new(ReturnHeader990N.ReturnHeader) },
+	},
+	"ReturnHeader990x": {
+		"ReturnHeader": func() any { return new(ReturnHeader990x.ReturnHeader) },
+	},
+	"SSBICElectionPostponedGainStatement": {
+		"SSBICElectionPostponedGainStmt": func() any { return new(SSBICElectionPostponedGainStatement.SsbicelectionPostponedGainStmt) },
+	},
+	"Section1202ExclusionStatement": {
+		"Section1202ExclusionStatement": func() any { return new(Section1202ExclusionStatement.Section1202ExclusionStatement) },
+	},
+	"Section1294AdjustmentSchedule": {
+		"Section1294AdjustmentSchedule": func() any { return new(Section1294AdjustmentSchedule.Section1294AdjustmentSchedule) },
+	},
+	"Section168f1PropertyExplanationStatement": {
+		"Section168f1PropertyStatement": func() any { return new(Section168f1PropertyExplanationStatement.Section168F1PropertyStatement) },
+	},
+	"Section179ZoneEnterprisePropertyStatement": {
+		"Sect179ZoneEnterprisePropStmt": func() any { return new(Section179ZoneEnterprisePropertyStatement.Sect179ZoneEnterprisePropStmt) },
+	},
+	"Section42j5Schedule": {
+		"Section42j5Schedule": func() any { return new(Section42j5Schedule.Section42J5Schedule) },
+	},
+	"Section481aAdjustmentStatement": {
+		"Section481aAdjustmentStatement": func() any { return new(Section481aAdjustmentStatement.Section481AAdjustmentStatement) },
+	},
+	"Section59e2ExpenditureStatement": {
+		"Section59e2ExpenditureStmt": func() any { return new(Section59e2ExpenditureStatement.Section59E2ExpenditureStmt) },
+	},
+	"Section942a3Schedule": {
+		"Section942a3Schedule": func() any { return new(Section942a3Schedule.Section942A3Schedule) },
+	},
+	"SetAsideSchedule": {
+		"SetAsideSchedule": func() any { return new(SetAsideSchedule.SetAsideSchedule) },
+	},
+	"SmallEthanolProducerCreditStatement": {
+		"SmallEthanolProducerCreditStmt": func() any { return new(SmallEthanolProducerCreditStatement.SmallEthanolProducerCreditStmt) },
+	},
+	"StraightlineDepreciationSchedule": {
+		"StraightlineDepreciationSch": func() any { return new(StraightlineDepreciationSchedule.StraightlineDepreciationSch) },
+	},
+	"SupplementalInformationStatement": {
+		"SupplementalInfoStatement": func() any { return new(SupplementalInformationStatement.SupplementalInfoStatement) },
+	},
+	"TabularScheduleOfTransactions": {
+		"TabularScheduleOfTransactions": func() any { return new(TabularScheduleOfTransactions.TabularScheduleOfTransactions) },
+	},
+	"ToWhomDieselFuelSoldStatement": {
+		"ToWhomDieselFuelSoldStatement": func() any { return new(ToWhomDieselFuelSoldStatement.ToWhomDieselFuelSoldStatement) },
+	},
+	"ToWhomKeroseneFuelSoldStatement": {
+		"ToWhomKeroseneFuelSoldStmt": func() any { return new(ToWhomKeroseneFuelSoldStatement.ToWhomKeroseneFuelSoldStmt) },
+	},
+	"TradeOrBusinessStatement": {
+		"TradeOrBusinessStatement": func() any { return new(TradeOrBusinessStatement.TradeOrBusinessStatement) },
+	},
+	"TransactionsInLieuOfTheFSCProvisionsSchedule": {
+		"TransInLieuOfFSCProvisionsSch": func() any { return new(TransactionsInLieuOfTheFSCProvisionsSchedule.TransInLieuOfFscprovisionsSch) },
+	},
+	"UnableToFurnishCopyOfForm970Statement": {
+		"UnableToFurnishCopyOf970Stmt": func() any { return new(UnableToFurnishCopyOfForm970Statement.UnableToFurnishCopyOf970Stmt) },
+	},
+	"UnrealizedAppreciationOfCollectiblesStatement": {
+		"UnrlzdApprcnCollectiblesStmt": func() any { return new(UnrealizedAppreciationOfCollectiblesStatement.UnrlzdApprcnCollectiblesStmt) },
+	},
+	"UnrecapturedSection1250GainSchedule": {
+		"UnrecapturedSection1250GainSch": func() any { return new(UnrecapturedSection1250GainSchedule.UnrecapturedSection1250GainSch) },
+	},
+	"UnusedCapitalLossCarryoverSchedule": {
+		"UnusedCapLossCarryoverCmptn": func() any { return new(UnusedCapitalLossCarryoverSchedule.UnusedCapLossCarryoverCmptn) },
+	},
+	"UseOfProposedMethodOfAccountingStatement": {
+		"UseOfProposedMethodOfAcctStmt": func() any { return new(UseOfProposedMethodOfAccountingStatement.UseOfProposedMethodOfAcctStmt) },
+	},
+	"WholesaleOrRetailGoodsProposedNumOfPoolsStatement": {
+		"WholesaleRetailPrpsdPoolsStmt": func() any {
+			return new(WholesaleOrRetailGoodsProposedNumOfPoolsStatement.WholesaleRetailPrpsdPoolsStmt)
+		},
+	},
+	"efile": {
+		"Return":       func() any { return new(efile.Return) },
+		"ReturnHeader": func() any { return new(efile.ReturnHeader) },
+	},
+	"efileAttachments": {
+		"AckNotificationList":     func() any { return new(efileAttachments.AckNotificationList) },
+		"AcknowledgementList":     func() any { return new(efileAttachments.AcknowledgementList) },
+		"IRSSubmissionManifest":   func() any { return new(efileAttachments.IrssubmissionManifest) },
+		"StateSubmissionManifest": func() any { return new(efileAttachments.StateSubmissionManifest) },
+		"StatusRecordList":        func() any { return new(efileAttachments.StatusRecordList) },
+		"SubmissionReceiptList":   func() any { return new(efileAttachments.SubmissionReceiptList) },
+	},
+	"efileMessageCommon": {
+		"TransmissionManifest": func() any { return new(efileMessageCommon.TransmissionManifest) },
+	},
+	"efileMessageIFA": {
+		"IFATransmissionHeader": func() any { return new(efileMessageIFA.IfatransmissionHeader) },
+	},
+	"tns": {
+		"Envelope": func() any { return new(tns.Envelope) },
+	},
+}
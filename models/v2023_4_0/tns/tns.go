@@ -0,0 +1,98 @@
+// Code generated by https://github.com/gocomply/xsd2go and the schemas command; DO NOT EDIT.
+// Models for http://schemas.xmlsoap.org/soap/envelope/
+package tns
+
+import (
+	"encoding/xml"
+	"github.com/synergos-systems/xsd"
+)
+
+// Element
+type Envelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+
+	Header *Header `xml:"Header"`
+
+	Body Body `xml:"Body"`
+}
+
+// Element
+type Header struct {
+	XMLName xml.Name `xml:"Header"`
+}
+
+// Element
+type Body struct {
+	XMLName xml.Name `xml:"Body"`
+}
+
+type Fault struct {
+	XMLName xml.Name
+
+	Faultcode string `xml:"faultcode"`
+
+	Faultstring string `xml:"faultstring"`
+
+	Faultactor string `xml:"faultactor"`
+
+	Detail *Detail `xml:"detail"`
+}
+
+type Detail struct {
+	XMLName xml.Name
+}
+
+type EncodingStyle string
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *Envelope) Validate() error {
+	var errs xsd.Errors
+	if v.Header != nil {
+		errs.Add("Header", v.Header.Validate())
+	}
+	errs.Add("Body", v.Body.Validate())
+	return errs.Err()
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *Header) Validate() error {
+	return nil
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *Body) Validate() error {
+	return nil
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *Fault) Validate() error {
+	var errs xsd.Errors
+	if v.Faultcode == "" {
+		errs.Add("faultcode", xsd.ErrMissing)
+	}
+	if v.Faultstring == "" {
+		errs.Add("faultstring", xsd.ErrMissing)
+	}
+	if v.Faultactor == "" {
+		errs.Add("faultactor", xsd.ErrMissing)
+	}
+	if v.Detail != nil {
+		errs.Add("detail", v.Detail.Validate())
+	}
+	return errs.Err()
+}
+
+// Validate checks the element and its children against the schema,
+// returning every violation with its element path
+func (v *Detail) Validate() error {
+	return nil
+}
+
+// Validate checks the value against the facets of its schema type
+func (v EncodingStyle) Validate() error {
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// modelScalar is a type in package xsd that reads and writes an IRS simple
+// type the way filings spell it, which encoding/xml cannot: a checkbox is X,
+// a boolean may be 1 or 0, and dates carry time zones
+type modelScalar struct {
+	// Type is the type in package xsd, such as Date
+	Type string
+	// Kind is what the Validate methods see: the Go kind facets are checked
+	// against, or checkbox and time, which are only checked for presence
+	Kind string
+}
+
+var (
+	// scalarsByName picks the runtime type of the simple types every IRS
+	// schema version declares in efileTypes.xsd
+	scalarsByName = map[string]modelScalar{
+		"CheckboxType":  {"Checkbox", "checkbox"},
+		"BooleanType":   {"Boolean", "bool"},
+		"DateType":      {"Date", "time"},
+		"TimestampType": {"Timestamp", "time"},
+		"YearType":      {"Year", "time"},
+	}
+	// scalarsByBase picks it for the other simple types restricting the same
+	// built-in types, when the schemas are known
+	scalarsByBase = map[string]modelScalar{
+		"boolean":  scalarsByName["BooleanType"],
+		"date":     scalarsByName["DateType"],
+		"dateTime": scalarsByName["TimestampType"],
+		"gYear":    scalarsByName["YearType"],
+	}
+	// amountScalar is the checked integer of whole-dollar amounts, such as
+	// UsamountType and ForeignAmountNntype
+	amountScalar = modelScalar{"Amount", "int64"}
+)
+
+// applyModelScalars declares the simple types that need their own XML
+// marshalling over their type in package xsd
+func applyModelScalars(documents []*modelDocument, facets *SchemaFacets) {
+	for _, document := range documents {
+		for _, t := range document.Types {
+			if scalar := scalarFor(t, facets); scalar != nil {
+				t.Scalar = scalar
+				t.Spec.Type = &ast.SelectorExpr{X: ast.NewIdent("xsd"), Sel: ast.NewIdent(scalar.Type)}
+			}
+		}
+	}
+}
+
+// scalarFor picks the runtime type of a simple type, or nil if encoding/xml
+// reads it as it is
+func scalarFor(t *modelType, facets *SchemaFacets) *modelScalar {
+	kind, ok := t.Spec.Type.(*ast.Ident)
+	if !ok || t.Element {
+		return nil
+	}
+	switch kind.Name {
+	case "int64", "uint64":
+		if strings.Contains(strings.ToLower(t.Name), "amount") {
+			return &amountScalar
+		}
+	case "string", "bool":
+		if scalar, ok := scalarsByName[t.Name]; ok {
+			return &scalar
+		}
+		if f := facets.typeFacets(t.Name); f != nil {
+			if scalar, ok := scalarsByBase[f.Base]; ok {
+				return &scalar
+			}
+		}
+	}
+	return nil
+}
+
+// writeScalar hands the XML marshalling and spelling of a type to its type
+// in package xsd
+func (w *validateWriter) writeScalar(t *modelType) {
+	runtime := "xsd." + t.Scalar.Type
+	fmt.Fprintf(&w.code, "// UnmarshalXML reads the element as %s does\n", runtime)
+	fmt.Fprintf(&w.code, "func (v *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {\n\treturn (*%s)(v).UnmarshalXML(d, start)\n}\n\n", t.Name, runtime)
+	fmt.Fprintf(&w.code, "// UnmarshalXMLAttr reads the attribute as %s does\n", runtime)
+	fmt.Fprintf(&w.code, "func (v *%s) UnmarshalXMLAttr(attr xml.Attr) error {\n\treturn (*%s)(v).UnmarshalXMLAttr(attr)\n}\n\n", t.Name, runtime)
+	fmt.Fprintf(&w.code, "// MarshalXML writes the element as %s does\n", runtime)
+	fmt.Fprintf(&w.code, "func (v %s) MarshalXML(e *xml.Encoder, start xml.StartElement) error {\n\treturn %s(v).MarshalXML(e, start)\n}\n\n", t.Name, runtime)
+	fmt.Fprintf(&w.code, "// MarshalXMLAttr writes the attribute as %s does\n", runtime)
+	fmt.Fprintf(&w.code, "func (v %s) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {\n\treturn %s(v).MarshalXMLAttr(name)\n}\n\n", t.Name, runtime)
+	fmt.Fprintf(&w.code, "// String spells the value as %s does\n", runtime)
+	fmt.Fprintf(&w.code, "func (v %s) String() string {\n\treturn %s(v).String()\n}\n\n", t.Name, runtime)
+	w.usesXSD = true
+}
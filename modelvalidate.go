@@ -0,0 +1,355 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// xsdImportPath is the package the generated Validate methods check facets with
+const xsdImportPath = "github.com/synergos-systems/xsd"
+
+// modelScope is what the Validate methods of one package can see
+type modelScope struct {
+	// kinds maps every type the package can refer to, its own and the shared
+	// ones, to the Go type underneath: struct, string, int64, uint64, float64 or bool
+	kinds map[string]string
+	// elements maps the types of elements to the element name
+	elements map[string]string
+	facets   *SchemaFacets
+}
+
+// validateWriter writes the Validate methods of one package
+type validateWriter struct {
+	scope *modelScope
+	vars  bytes.Buffer
+	code  bytes.Buffer
+	// declared holds the facet variables written so far
+	declared map[string]bool
+	usesXSD  bool
+	// Facets counts the types checked against schema facets, and Dropped the
+	// patterns Go cannot run
+	Facets  int
+	Dropped []string
+	// Enums counts the types given constants for their enumeration
+	Enums int
+}
+
+// modelKinds maps each declared type to the Go type underneath
+func modelKinds(types []*modelType, kinds map[string]string) {
+	for _, t := range types {
+		if t.Scalar != nil {
+			kinds[t.Name] = t.Scalar.Kind
+			continue
+		}
+		switch e := t.Spec.Type.(type) {
+		case *ast.StructType:
+			kinds[t.Name] = "struct"
+		case *ast.Ident:
+			kinds[t.Name] = e.Name
+		}
+	}
+}
+
+// modelElements maps the element types among types to their element names
+func modelElements(types []*modelType, elements map[string]string) {
+	for _, t := range types {
+		if t.Element {
+			if name := modelElementName(t.Spec); name != "" {
+				elements[t.Name] = name
+			}
+		}
+	}
+}
+
+func newValidateWriter(scope *modelScope) *validateWriter {
+	return &validateWriter{scope: scope, declared: make(map[string]bool)}
+}
+
+// write adds the Validate method of one type
+func (w *validateWriter) write(t *modelType) {
+	switch e := t.Spec.Type.(type) {
+	case *ast.StructType:
+		w.writeStruct(t.Name, e)
+	case *ast.Ident:
+		w.writeSimple(t.Name, e.Name)
+	case *ast.SelectorExpr:
+		if t.Scalar != nil {
+			w.writeScalar(t)
+			w.writeSimple(t.Name, t.Scalar.Kind)
+		}
+	}
+}
+
+// writeSimple checks a simple type against its facets
+func (w *validateWriter) writeSimple(name, kind string) {
+	fmt.Fprintf(&w.code, "// Validate checks the value against the facets of its schema type\n")
+	fmt.Fprintf(&w.code, "func (v %s) Validate() error {\n", name)
+	if facets := w.scope.facets.typeFacets(name); facets != nil && kind != "bool" {
+		if check := w.check(lowerFirst(name)+"Facets", facets, kind, fmt.Sprintf("%s(v)", kind)); check != "" {
+			w.Facets++
+			fmt.Fprintf(&w.code, "\treturn %s\n}\n\n", check)
+			return
+		}
+	}
+	w.code.WriteString("\treturn nil\n}\n\n")
+}
+
+// writeStruct checks the attributes and children of an element, with the
+// element path of each error
+func (w *validateWriter) writeStruct(name string, st *ast.StructType) {
+	var body bytes.Buffer
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 1 || field.Tag == nil {
+			continue
+		}
+		tag, err := strconv.Unquote(field.Tag.Value)
+		if err != nil {
+			continue
+		}
+		w.writeField(&body, field.Names[0].Name, reflect.StructTag(tag).Get("xml"), field.Type)
+	}
+
+	fmt.Fprintf(&w.code, "// Validate checks the element and its children against the schema,\n// returning every violation with its element path\n")
+	fmt.Fprintf(&w.code, "func (v *%s) Validate() error {\n", name)
+	if body.Len() == 0 {
+		w.code.WriteString("\treturn nil\n}\n\n")
+		return
+	}
+	w.usesXSD = true
+	fmt.Fprintf(&w.code, "\tvar errs xsd.Errors\n%s\treturn errs.Err()\n}\n\n", body.String())
+}
+
+// writeField checks one field. Elements of a string, checkbox or date type
+// that are not optional in the schema, which xsd2go makes fields that are
+// neither pointers nor slices, must not be empty, unchecked or zero.
+func (w *validateWriter) writeField(body *bytes.Buffer, field, tag string, expr ast.Expr) {
+	name, flags, _ := strings.Cut(tag, ",")
+	if field == "XMLName" || name == "-" || strings.Contains(flags, "innerxml") || strings.Contains(flags, "comment") {
+		return
+	}
+	attr := strings.Contains(flags, "attr")
+	chardata := strings.Contains(flags, "chardata")
+
+	typeName, pointer, slice := modelFieldType(expr)
+	if typeName == "" {
+		return
+	}
+	kind, named := w.scope.kinds[typeName]
+	builtin := false
+	if !named {
+		if _, ok := expr.(*ast.SelectorExpr); ok {
+			return
+		}
+		kind, builtin = typeName, true
+	}
+
+	path := name
+	switch {
+	case attr:
+		path = "@" + name
+	case chardata:
+		path = ""
+	case strings.Contains(flags, "any"):
+		path = field
+		if element, ok := w.scope.elements[typeName]; ok {
+			path = element
+		}
+	}
+
+	value := "v." + field
+	var check string
+	if builtin {
+		facets := w.scope.facets.elementFacets(path)
+		if facets == nil {
+			if kind != "string" || attr || chardata || pointer || slice {
+				return
+			}
+		} else {
+			check = w.check(facetVar(path), facets, kind, "%s")
+		}
+	} else {
+		check = "%s.Validate()"
+	}
+
+	missing := missingCheck(kind, value)
+	required := missing != "" && !attr && !chardata && !pointer && !slice
+	switch {
+	case slice:
+		if check == "" {
+			return
+		}
+		fmt.Fprintf(body, "\tfor i := range %s {\n\t\terrs.Add(xsd.Index(%q, i), %s)\n\t}\n", value, path, fmt.Sprintf(check, value+"[i]"))
+	case pointer:
+		if check == "" {
+			return
+		}
+		if builtin {
+			fmt.Fprintf(body, "\tif %s != nil {\n\t\terrs.Add(%q, %s)\n\t}\n", value, path, fmt.Sprintf(check, "*"+value))
+		} else {
+			fmt.Fprintf(body, "\tif %s != nil {\n\t\terrs.Add(%q, %s)\n\t}\n", value, path, fmt.Sprintf(check, value))
+		}
+	case required:
+		fmt.Fprintf(body, "\tif %s {\n\t\terrs.Add(%q, xsd.ErrMissing)\n\t}", missing, path)
+		if check != "" {
+			fmt.Fprintf(body, " else {\n\t\terrs.Add(%q, %s)\n\t}", path, fmt.Sprintf(check, value))
+		}
+		body.WriteString("\n")
+	case check == "":
+	case attr && kind != "struct":
+		// Optional attributes are left at the zero value when absent
+		fmt.Fprintf(body, "\tif %s {\n\t\terrs.Add(%q, %s)\n\t}\n", presentCheck(kind, value), path, fmt.Sprintf(check, value))
+	default:
+		fmt.Fprintf(body, "\terrs.Add(%q, %s)\n", path, fmt.Sprintf(check, value))
+	}
+}
+
+// check declares the facets variable and returns the expression checking a
+// value of the given kind against it, with %s standing for the value when
+// value is "%s". It returns "" when no facet can be checked.
+func (w *validateWriter) check(variable string, facets *TypeFacets, kind, value string) string {
+	method := map[string]string{"string": "String", "int64": "Int", "uint64": "Uint", "float64": "Float"}[kind]
+	if method == "" || facets.Empty() {
+		return ""
+	}
+	literal := w.facetsLiteral(facets)
+	if literal == "" {
+		return ""
+	}
+	if !w.declared[variable] {
+		w.declared[variable] = true
+		fmt.Fprintf(&w.vars, "var %s = %s\n\n", variable, literal)
+	}
+	w.usesXSD = true
+	return fmt.Sprintf("%s.%s(%s)", variable, method, value)
+}
+
+// facetsLiteral writes facets as an xsd.Facets literal
+func (w *validateWriter) facetsLiteral(f *TypeFacets) string {
+	var fields []string
+	patterns, dropped := f.usablePatterns()
+	w.Dropped = append(w.Dropped, dropped...)
+	if len(patterns) > 0 {
+		quoted := make([]string, len(patterns))
+		for i, pattern := range patterns {
+			quoted[i] = strconv.Quote(pattern)
+		}
+		fields = append(fields, fmt.Sprintf("Patterns: xsd.Patterns(%s)", strings.Join(quoted, ", ")))
+	}
+	if len(f.Enumeration) > 0 {
+		quoted := make([]string, len(f.Enumeration))
+		for i, value := range f.Enumeration {
+			quoted[i] = strconv.Quote(value)
+		}
+		fields = append(fields, fmt.Sprintf("Enumeration: []string{%s}", strings.Join(quoted, ", ")))
+	}
+	for _, facet := range []struct {
+		name  string
+		value int
+	}{
+		{"Length", f.Length}, {"MinLength", f.MinLength}, {"MaxLength", f.MaxLength},
+		{"TotalDigits", f.TotalDigits}, {"FractionDigits", f.FractionDigits},
+	} {
+		if facet.value > 0 {
+			fields = append(fields, fmt.Sprintf("%s: %d", facet.name, facet.value))
+		}
+	}
+	for _, facet := range []struct{ name, value string }{
+		{"MinInclusive", f.MinInclusive}, {"MaxInclusive", f.MaxInclusive},
+		{"MinExclusive", f.MinExclusive}, {"MaxExclusive", f.MaxExclusive},
+	} {
+		if facet.value != "" {
+			fields = append(fields, fmt.Sprintf("%s: %q", facet.name, facet.value))
+		}
+	}
+	if len(fields) == 0 {
+		return ""
+	}
+	return "&xsd.Facets{" + strings.Join(fields, ", ") + "}"
+}
+
+// modelFieldType reads the type name of a field, and whether it is a pointer
+// or a slice
+func modelFieldType(expr ast.Expr) (name string, pointer, slice bool) {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		name, _, _ = modelFieldType(e.X)
+		return name, true, false
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return "", false, false
+		}
+		name, _, _ = modelFieldType(e.Elt)
+		return name, false, true
+	case *ast.Ident:
+		return e.Name, false, false
+	case *ast.SelectorExpr:
+		return e.Sel.Name, false, false
+	}
+	return "", false, false
+}
+
+// typeFacets returns the facets of a named simple type, if the schemas are known
+func (f *SchemaFacets) typeFacets(name string) *TypeFacets {
+	if f == nil {
+		return nil
+	}
+	return f.Types[name]
+}
+
+// elementFacets returns the inline facets of an element or attribute path
+// segment, if the schemas are known
+func (f *SchemaFacets) elementFacets(path string) *TypeFacets {
+	if f == nil || path == "" {
+		return nil
+	}
+	return f.Elements[path]
+}
+
+// facetVar names the variable holding the inline facets of an element or attribute
+func facetVar(path string) string {
+	if name, ok := strings.CutPrefix(path, "@"); ok {
+		return lowerFirst(modelPackageName(name)) + "AttrFacets"
+	}
+	return lowerFirst(modelPackageName(path)) + "ElementFacets"
+}
+
+// missingCheck is the condition under which a required element of a kind
+// counts as absent, or "" for kinds whose zero value is a valid value
+func missingCheck(kind, value string) string {
+	switch kind {
+	case "string":
+		return value + ` == ""`
+	case "checkbox":
+		return "!" + value
+	case "time":
+		return value + ".IsZero()"
+	}
+	return ""
+}
+
+// presentCheck is the condition under which a value of a kind is set
+func presentCheck(kind, value string) string {
+	switch kind {
+	case "string":
+		return value + ` != ""`
+	case "bool", "checkbox":
+		return value
+	case "time":
+		return "!" + value + ".IsZero()"
+	}
+	return value + " != 0"
+}
+
+func lowerFirst(name string) string {
+	runes := []rune(name)
+	if len(runes) == 0 {
+		return name
+	}
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"encoding/xml"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/synergos-systems/models/v2023_4_0/ReturnHeader990x"
+	"github.com/synergos-systems/xsd"
+)
+
+// validReturnHeader holds every element ReturnHeader990x requires
+const validReturnHeader = `<ReturnHeader binaryAttachmentCnt="0">
+  <ReturnTs>2024-05-01T10:00:00-05:00</ReturnTs>
+  <TaxPeriodEndDt>2023-12-31</TaxPeriodEndDt>
+  <SoftwareId>23009999</SoftwareId>
+  <MultSoftwarePackagesUsedInd>false</MultSoftwarePackagesUsedInd>
+  <OriginatorGrp><EFIN>123456</EFIN><OriginatorTypeCd>ERO</OriginatorTypeCd></OriginatorGrp>
+  <PINEnteredByCd>ERO</PINEnteredByCd>
+  <SignatureOptionCd>PIN Number</SignatureOptionCd>
+  <ReturnTypeCd>990</ReturnTypeCd>
+  <TaxPeriodBeginDt>2023-01-01</TaxPeriodBeginDt>
+  <Filer>
+    <EIN>123456789</EIN>
+    <BusinessName><BusinessNameLine1Txt>Example Foundation</BusinessNameLine1Txt></BusinessName>
+    <BusinessNameControlTxt>EXAM</BusinessNameControlTxt>
+  </Filer>
+  <BusinessOfficerGrp>
+    <PersonNm>Pat Example</PersonNm>
+    <PersonTitleTxt>Treasurer</PersonTitleTxt>
+    <SignatureDt>2024-05-01</SignatureDt>
+  </BusinessOfficerGrp>
+  <TaxYr>2023</TaxYr>
+</ReturnHeader>`
+
+func TestValidatePaths(t *testing.T) {
+	tests := []struct {
+		name string
+		// drop lists the elements removed from validReturnHeader
+		drop []string
+		want []string
+	}{
+		{name: "valid"},
+		{
+			name: "top level",
+			drop: []string{"<ReturnTs>2024-05-01T10:00:00-05:00</ReturnTs>", "<ReturnTypeCd>990</ReturnTypeCd>"},
+			want: []string{"ReturnTs", "ReturnTypeCd"},
+		},
+		{
+			name: "nested",
+			drop: []string{"<EIN>123456789</EIN>", "<PersonTitleTxt>Treasurer</PersonTitleTxt>"},
+			want: []string{"Filer/EIN", "BusinessOfficerGrp/PersonTitleTxt"},
+		},
+		{
+			name: "two levels",
+			drop: []string{"<BusinessNameLine1Txt>Example Foundation</BusinessNameLine1Txt>"},
+			want: []string{"Filer/BusinessName/BusinessNameLine1Txt"},
+		},
+		{
+			name: "empty group",
+			drop: []string{"<EFIN>123456</EFIN><OriginatorTypeCd>ERO</OriginatorTypeCd>"},
+			want: []string{"OriginatorGrp/EFIN", "OriginatorGrp/OriginatorTypeCd"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := validReturnHeader
+			for _, element := range tt.drop {
+				if !strings.Contains(doc, element) {
+					t.Fatalf("%s is not in the header", element)
+				}
+				doc = strings.Replace(doc, element, "", 1)
+			}
+
+			var header ReturnHeader990x.ReturnHeader
+			if err := xml.Unmarshal([]byte(doc), &header); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			if err := header.Validate(); err != nil {
+				var errs xsd.Errors
+				if !errors.As(err, &errs) {
+					t.Fatalf("Validate returned %T, want xsd.Errors", err)
+				}
+				for _, e := range errs {
+					if !errors.Is(e, xsd.ErrMissing) {
+						t.Errorf("%s: %v, want a missing element", e.Path, e.Err)
+					}
+					got = append(got, e.Path)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("paths = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
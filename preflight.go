@@ -0,0 +1,208 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// preflightReserve is left free on top of a stage's estimate, covering the
+// staged copies of changed archives and anything else writing to the disk
+const preflightReserve = 1 << 30
+
+var errNoDiskFree = errors.New("free space cannot be measured on this platform")
+
+// Preflight estimates the disk space a stage is about to use
+type Preflight struct {
+	Stage string
+	// Dir is the local directory the stage writes to; empty when it writes
+	// to an object store, which has no space to run out of
+	Dir  string
+	Need int64
+	// Unknown counts items whose size could not be estimated
+	Unknown int
+}
+
+// localDir returns the directory that holds key when store keeps its objects
+// on local disk
+func localDir(store Storage, key string) (string, bool) {
+	if store == nil {
+		return filepath.FromSlash(key), true
+	}
+	local, ok := store.(*LocalStorage)
+	if !ok {
+		return "", false
+	}
+	return filepath.Join(local.Root, filepath.FromSlash(key)), true
+}
+
+// existingDir walks up from dir to the nearest directory that exists, which
+// is on the filesystem dir will be created on
+func existingDir(dir string) string {
+	for {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dir
+		}
+		dir = parent
+	}
+}
+
+// Print writes the estimate and the free space next to it
+func (p Preflight) Print(w io.Writer) {
+	if p.Dir == "" {
+		return
+	}
+	fmt.Fprintf(w, "Preflight: %s needs about %s in %s", p.Stage, formatBytes(p.Need), p.Dir)
+	if free, err := diskFree(existingDir(p.Dir)); err == nil {
+		fmt.Fprintf(w, ", %s free", formatBytes(int64(free)))
+	}
+	if p.Unknown > 0 {
+		fmt.Fprintf(w, " (%d sizes unknown)", p.Unknown)
+	}
+	fmt.Fprintln(w)
+}
+
+// Check fails when the estimate plus preflightReserve does not fit in the free
+// space of Dir. Platforms that cannot measure free space always pass.
+func (p Preflight) Check() error {
+	if p.Dir == "" || p.Need == 0 {
+		return nil
+	}
+	free, err := diskFree(existingDir(p.Dir))
+	if errors.Is(err, errNoDiskFree) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	p.Print(os.Stdout)
+	if uint64(p.Need)+preflightReserve > free {
+		return fmt.Errorf("not enough free space to %s: need about %s plus %s in reserve in %s but only %s is free; free some space (see the gc command) or pass -skip-preflight",
+			p.Stage, formatBytes(p.Need), formatBytes(preflightReserve), p.Dir, formatBytes(int64(free)))
+	}
+	return nil
+}
+
+// downloadPreflight estimates the space needed to download the archives at
+// urls that store does not hold yet. Sizes come from the catalog or an
+// earlier backfill where known and from HEAD requests otherwise.
+func downloadPreflight(src Source, catalog *Catalog, store Storage, urls []string) Preflight {
+	preflight := Preflight{Stage: "download archives"}
+	dir, ok := localDir(store, zipBaseDir)
+	if !ok {
+		return preflight
+	}
+	preflight.Dir = dir
+	if store == nil {
+		store = &LocalStorage{Root: "."}
+	}
+
+	ledger, err := LoadBackfillLedger(backfillLedgerPath)
+	if err != nil {
+		ledger = &BackfillLedger{Archives: make(map[string]*ArchiveProbe)}
+	}
+
+	var probe []string
+	for _, url := range urls {
+		file := extractFilenameFromURL(url)
+		if _, err := store.Stat(storageKey(zipBaseDir, file)); err == nil {
+			continue
+		}
+		if entry, ok := catalog.Entries[file]; ok && entry.Size > 0 {
+			preflight.Need += entry.Size
+			continue
+		}
+		if known, ok := ledger.Archives[file]; ok && known.Size > 0 {
+			preflight.Need += known.Size
+			continue
+		}
+		probe = append(probe, url)
+	}
+
+	var mu sync.Mutex
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	for w := 0; w < defaultDownloadWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for url := range queue {
+				remote, err := src.Head(url)
+				mu.Lock()
+				if err != nil || remote.Size < 0 {
+					preflight.Unknown++
+				} else {
+					preflight.Need += remote.Size
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, url := range probe {
+		queue <- url
+	}
+	close(queue)
+	wg.Wait()
+
+	return preflight
+}
+
+// extractPreflight estimates the space needed to extract the named archives
+// from the uncompressed sizes in their central directories. Archives that
+// are already extracted and unchanged, and entries already on disk at their
+// full size, are not counted. With a filing filter the estimate is an upper
+// bound, since filings are only filtered once their headers are read.
+func extractPreflight(store Storage, names []string, opts ExtractOptions) Preflight {
+	preflight := Preflight{Stage: "extract archives"}
+	dir, ok := localDir(store, zipBaseDir)
+	if !ok {
+		return preflight
+	}
+	preflight.Dir = dir
+	if store == nil {
+		store = &LocalStorage{Root: "."}
+	}
+
+	filter := opts.Filter.String()
+	for _, name := range names {
+		zipKey := storageKey(zipBaseDir, name)
+		dirKey := storageKey(zipBaseDir, strings.TrimSuffix(name, path.Ext(name)))
+
+		info, err := store.Stat(zipKey)
+		if err != nil {
+			preflight.Unknown++
+			continue
+		}
+		state, _ := readStoredExtractionState(store, storageKey(dirKey, extractedMarker))
+		if !opts.Force && state != nil && state.Filter == filter && state.Size == info.Size && state.ModTime.Equal(info.ModTime) {
+			continue
+		}
+
+		reader, closer, err := openZip(store, zipKey)
+		if err != nil {
+			preflight.Unknown++
+			continue
+		}
+		for _, file := range reader.File {
+			if file.FileInfo().IsDir() {
+				continue
+			}
+			if existing, err := store.Stat(storageKey(dirKey, file.Name)); err == nil && uint64(existing.Size) == file.UncompressedSize64 {
+				continue
+			}
+			preflight.Need += int64(file.UncompressedSize64)
+		}
+		closer.Close()
+	}
+	return preflight
+}
@@ -0,0 +1,344 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const retentionPath = "./data/retention.json"
+
+// RetentionPolicy says which raw data gc may delete. The zero policy keeps
+// everything.
+type RetentionPolicy struct {
+	// KeepYears keeps the archives of the latest N years, counting the
+	// current one. Older archives are deleted along with everything derived
+	// from them, and sync and backfill stop fetching them. Zero keeps every year.
+	KeepYears int `json:"keep_years,omitempty"`
+	// DeleteExtracted deletes the extracted XML of an archive once the
+	// daemon has written the archive's own CSV since the archive changed.
+	// The archive is kept, so unzip can restore them.
+	DeleteExtracted bool `json:"delete_extracted,omitempty"`
+}
+
+// LoadRetentionPolicy reads the policy at path, returning the zero policy if
+// it does not exist
+func LoadRetentionPolicy(path string) (RetentionPolicy, error) {
+	var policy RetentionPolicy
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return policy, nil
+	}
+	if err != nil {
+		return policy, fmt.Errorf("failed to read retention policy: %w", err)
+	}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return policy, fmt.Errorf("failed to parse retention policy %s: %w", path, err)
+	}
+	if policy.KeepYears < 0 {
+		return policy, fmt.Errorf("invalid retention policy %s: keep_years must not be negative", path)
+	}
+	return policy, nil
+}
+
+// Save writes the policy to path
+func (p RetentionPolicy) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create policy directory: %w", err)
+	}
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode retention policy: %w", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write retention policy: %w", err)
+	}
+	return nil
+}
+
+// Empty reports whether the policy keeps everything
+func (p RetentionPolicy) Empty() bool {
+	return p.KeepYears == 0 && !p.DeleteExtracted
+}
+
+// String describes the policy for log output
+func (p RetentionPolicy) String() string {
+	if p.Empty() {
+		return "keep everything"
+	}
+	var rules []string
+	if p.KeepYears > 0 {
+		rules = append(rules, fmt.Sprintf("keep archives from %d on", p.firstYear()))
+	}
+	if p.DeleteExtracted {
+		rules = append(rules, "delete extracted XML once processed")
+	}
+	return strings.Join(rules, ", ")
+}
+
+// firstYear is the oldest archive year the policy keeps
+func (p RetentionPolicy) firstYear() int {
+	return time.Now().Year() - p.KeepYears + 1
+}
+
+// Retains reports whether the policy keeps an archive. Names that do not
+// follow a known scheme are always kept.
+func (p RetentionPolicy) Retains(file string) bool {
+	if p.KeepYears == 0 {
+		return true
+	}
+	archive, ok := ParseArchiveName(file)
+	return !ok || archive.Year >= p.firstYear()
+}
+
+// Apply keeps the URLs of archives the policy retains, so nothing gc would
+// delete again is downloaded
+func (p RetentionPolicy) Apply(urls []string) []string {
+	if p.KeepYears == 0 {
+		return urls
+	}
+
+	var kept []string
+	for _, url := range urls {
+		if p.Retains(extractFilenameFromURL(url)) {
+			kept = append(kept, url)
+		}
+	}
+	if dropped := len(urls) - len(kept); dropped > 0 {
+		fmt.Printf("Skipping %d archives from before %d, which the retention policy does not keep\n", dropped, p.firstYear())
+	}
+	return kept
+}
+
+// GCItem is one thing gc deletes: a single object, or every object under a
+// prefix for an extracted directory
+type GCItem struct {
+	Key     string
+	Tree    bool
+	Objects int
+	Size    int64
+	Reason  string
+	// Archive is the archive the item belongs to
+	Archive string
+}
+
+// GCPlan lists what gc will delete, in the order it deletes it
+type GCPlan struct {
+	Policy RetentionPolicy
+	Items  []GCItem
+}
+
+// archiveFiles collects what data/990_zips holds for one archive
+type archiveFiles struct {
+	zip        *ObjectInfo
+	dirObjects int
+	dirSize    int64
+	extracted  bool
+	extracting bool
+}
+
+// PlanGC works out what the policy deletes from store. Extracted directories
+// are only deleted while their archive is held, and never while an
+// extraction of them is unfinished.
+func PlanGC(store Storage, policy RetentionPolicy) (*GCPlan, error) {
+	plan := &GCPlan{Policy: policy}
+	if policy.Empty() {
+		return plan, nil
+	}
+
+	objects, err := store.List(zipBaseDir + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	archives := make(map[string]*archiveFiles)
+	for _, object := range objects {
+		rel := strings.TrimPrefix(object.Key, zipBaseDir+"/")
+		base, rest, nested := strings.Cut(rel, "/")
+		if !nested {
+			if !strings.HasSuffix(strings.ToLower(base), ".zip") {
+				continue
+			}
+			base = strings.TrimSuffix(base, path.Ext(base))
+		}
+		files := archives[base]
+		if files == nil {
+			files = &archiveFiles{}
+			archives[base] = files
+		}
+
+		switch {
+		case !nested:
+			info := object
+			files.zip = &info
+		case rest == extractedMarker:
+			files.extracted = true
+		case rest == extractingMarker:
+			files.extracting = true
+		}
+		if nested {
+			files.dirObjects++
+			files.dirSize += object.Size
+		}
+	}
+
+	names := make([]string, 0, len(archives))
+	for name := range archives {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		files := archives[name]
+		archive := name + ".zip"
+		dirKey := storageKey(zipBaseDir, name)
+
+		if !policy.Retains(archive) {
+			reason := fmt.Sprintf("before %d", policy.firstYear())
+			if files.dirObjects > 0 {
+				plan.Items = append(plan.Items, GCItem{Key: dirKey, Tree: true, Objects: files.dirObjects, Size: files.dirSize, Reason: reason, Archive: archive})
+			}
+			for _, key := range []string{archiveCSVKey(archive), manifestKey(archive)} {
+				if info, err := store.Stat(key); err == nil {
+					plan.Items = append(plan.Items, GCItem{Key: key, Objects: 1, Size: info.Size, Reason: reason, Archive: archive})
+				}
+			}
+			// The archive goes last, so an interrupted gc finds the rest again
+			if files.zip != nil {
+				plan.Items = append(plan.Items, GCItem{Key: files.zip.Key, Objects: 1, Size: files.zip.Size, Reason: reason, Archive: archive})
+			}
+			continue
+		}
+
+		if policy.DeleteExtracted && files.dirObjects > 0 && files.zip != nil && files.extracted && !files.extracting {
+			if processedSince(store, archive, files.zip.ModTime) {
+				plan.Items = append(plan.Items, GCItem{Key: dirKey, Tree: true, Objects: files.dirObjects, Size: files.dirSize, Reason: "extracted and processed", Archive: archive})
+			}
+		}
+	}
+	return plan, nil
+}
+
+// processedSince reports whether the archive's own CSV was written after the
+// archive. irs_990_data.csv does not count: the csv command may have been run
+// on a selection of filings, so a newer copy does not show the archive is in it.
+func processedSince(store Storage, archive string, modTime time.Time) bool {
+	info, err := store.Stat(archiveCSVKey(archive))
+	return err == nil && !info.ModTime.Before(modTime)
+}
+
+// Bytes returns the total size of the plan
+func (p *GCPlan) Bytes() int64 {
+	var total int64
+	for _, item := range p.Items {
+		total += item.Size
+	}
+	return total
+}
+
+// Print lists the plan
+func (p *GCPlan) Print(w io.Writer) {
+	fmt.Fprintf(w, "Retention policy: %s\n", p.Policy)
+	if len(p.Items) == 0 {
+		fmt.Fprintln(w, "Nothing to delete.")
+		return
+	}
+	for _, item := range p.Items {
+		if item.Tree {
+			fmt.Fprintf(w, "  %s/ (%d files, %s): %s\n", item.Key, item.Objects, formatBytes(item.Size), item.Reason)
+		} else {
+			fmt.Fprintf(w, "  %s (%s): %s\n", item.Key, formatBytes(item.Size), item.Reason)
+		}
+	}
+	fmt.Fprintf(w, "%d items, %s in total\n", len(p.Items), formatBytes(p.Bytes()))
+}
+
+// Apply deletes the plan from store and returns the bytes reclaimed. Filings
+// queued for reprocessing in deleted archives are dropped from the queue.
+func (p *GCPlan) Apply(store Storage) (int64, error) {
+	var reclaimed int64
+	removed := make(map[string]bool)
+	for _, item := range p.Items {
+		var err error
+		if item.Tree {
+			err = removeTree(store, item.Key)
+		} else {
+			err = store.Remove(item.Key)
+		}
+		if err != nil {
+			return reclaimed, fmt.Errorf("failed to delete %s: %w", item.Key, err)
+		}
+		reclaimed += item.Size
+		if path.Dir(item.Key) == zipBaseDir && !item.Tree {
+			removed[item.Archive] = true
+		}
+	}
+
+	if len(removed) > 0 {
+		queue, err := LoadReprocessQueue(reprocessPath)
+		if err != nil {
+			return reclaimed, err
+		}
+		for archive := range removed {
+			queue.Clear(archive)
+		}
+		if err := queue.Save(); err != nil {
+			return reclaimed, err
+		}
+	}
+	return reclaimed, nil
+}
+
+// removeTree deletes every object under prefix, and on local disk the
+// directories left empty
+func removeTree(store Storage, prefix string) error {
+	objects, err := store.List(prefix + "/")
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if err := store.Remove(object.Key); err != nil {
+			return err
+		}
+	}
+	if local, ok := store.(*LocalStorage); ok {
+		dir, err := local.path(prefix)
+		if err != nil {
+			return err
+		}
+		return os.RemoveAll(dir)
+	}
+	return nil
+}
+
+// RunGC applies the retention policy to store. It holds the daemon's lock
+// while it runs, so it never deletes what a running daemon is working on.
+func RunGC(store Storage, policy RetentionPolicy, dryRun bool) error {
+	if !dryRun {
+		release, err := acquireLock(daemonLockPath)
+		if err != nil {
+			return err
+		}
+		defer release()
+	}
+
+	plan, err := PlanGC(store, policy)
+	if err != nil {
+		return err
+	}
+	plan.Print(os.Stdout)
+	if dryRun || len(plan.Items) == 0 {
+		return nil
+	}
+
+	reclaimed, err := plan.Apply(store)
+	fmt.Printf("Reclaimed %s\n", formatBytes(reclaimed))
+	return err
+}
@@ -0,0 +1,649 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// s3PartSize is how much of a streamed write is buffered before it is
+	// sent as one part of a multipart upload; S3 requires at least 5 MiB
+	s3PartSize = 16 << 20
+	// s3IdleStreams bounds the range reads an open object keeps for reuse
+	s3IdleStreams = 8
+	// s3EmptyHash is the SHA-256 of an empty payload
+	s3EmptyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3Storage keeps objects in a bucket of an S3-compatible object store such
+// as AWS S3 or MinIO. Requests use path-style addressing and are signed with
+// AWS Signature Version 4.
+type S3Storage struct {
+	Endpoint *url.URL
+	Bucket   string
+	// Prefix is prepended to every key, so several stores can share a bucket
+	Prefix       string
+	Region       string
+	AccessKey    string
+	SecretKey    string
+	SessionToken string
+	Client       *Client
+}
+
+// NewS3StorageFromEnv builds an S3Storage for s3://bucket/prefix using the
+// standard AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, AWS_SESSION_TOKEN and
+// AWS_REGION variables. AWS_ENDPOINT_URL points it at another S3-compatible
+// server, such as http://localhost:9000 for a local MinIO.
+func NewS3StorageFromEnv(spec string) (*S3Storage, error) {
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(spec, "s3://"), "/")
+	if bucket == "" {
+		return nil, fmt.Errorf("invalid storage %q: missing bucket", spec)
+	}
+
+	region := firstEnv("AWS_REGION", "AWS_DEFAULT_REGION")
+	if region == "" {
+		region = "us-east-1"
+	}
+	endpoint := firstEnv("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL")
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", region)
+	}
+	base, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid S3 endpoint %q: %w", endpoint, err)
+	}
+
+	store := &S3Storage{
+		Endpoint:     base,
+		Bucket:       bucket,
+		Prefix:       strings.Trim(prefix, "/"),
+		Region:       region,
+		AccessKey:    os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretKey:    os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken: os.Getenv("AWS_SESSION_TOKEN"),
+	}
+	if store.AccessKey == "" || store.SecretKey == "" {
+		return nil, fmt.Errorf("storage %s needs AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY", spec)
+	}
+
+	// Object stores are ours, so they are not rate limited like the IRS
+	config := DefaultClientConfig()
+	config.RequestsPerSecond = 0
+	config.MaxConnsPerHost = 4 * defaultDownloadWorkers
+	store.Client = NewClient(config)
+	return store, nil
+}
+
+// firstEnv returns the first of the named environment variables that is set
+func firstEnv(names ...string) string {
+	for _, name := range names {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Name describes the store for log output
+func (s *S3Storage) Name() string {
+	if s.Prefix == "" {
+		return "s3://" + s.Bucket
+	}
+	return "s3://" + s.Bucket + "/" + s.Prefix
+}
+
+// objectName maps a key onto the object name in the bucket
+func (s *S3Storage) objectName(key string) string {
+	key = strings.TrimPrefix(key, "/")
+	if s.Prefix == "" {
+		return key
+	}
+	return s.Prefix + "/" + key
+}
+
+// request sends a signed request for the object name (the bucket itself when
+// empty). Bodies are held in memory so failed requests can be retried.
+func (s *S3Storage) request(method, name string, query url.Values, body []byte, header http.Header) (*http.Response, error) {
+	u := *s.Endpoint
+	rawPath := strings.TrimSuffix(s.Endpoint.EscapedPath(), "/") + "/" + awsEscape(s.Bucket, false)
+	if name != "" {
+		rawPath += "/" + awsEscape(name, false)
+	}
+	u.RawPath = rawPath
+	u.Path, _ = url.PathUnescape(rawPath)
+	u.RawQuery = canonicalQuery(query)
+
+	var reader io.Reader
+	payloadHash := s3EmptyHash
+	if body != nil {
+		reader = bytes.NewReader(body)
+		sum := sha256.Sum256(body)
+		payloadHash = hex.EncodeToString(sum[:])
+	}
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	s.sign(req, rawPath, payloadHash, time.Now().UTC())
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s %s: %w", method, s.Name()+"/"+name, err)
+	}
+	return res, nil
+}
+
+// s3Error describes an unsuccessful response, reading S3's error document
+func s3Error(res *http.Response, name string) error {
+	defer res.Body.Close()
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%s: %w", name, fs.ErrNotExist)
+	}
+
+	var doc struct {
+		Code    string
+		Message string
+	}
+	data, _ := io.ReadAll(io.LimitReader(res.Body, 64<<10))
+	if xml.Unmarshal(data, &doc) == nil && doc.Code != "" {
+		return fmt.Errorf("%s: %s: %s", name, doc.Code, doc.Message)
+	}
+	return fmt.Errorf("%s: HTTP error %d", name, res.StatusCode)
+}
+
+// sign adds an AWS Signature Version 4 authorization header to req
+func (s *S3Storage) sign(req *http.Request, canonicalURI, payloadHash string, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		lower := strings.ToLower(name)
+		if strings.HasPrefix(lower, "x-amz-") || lower == "content-type" || lower == "content-md5" {
+			headers[lower] = strings.TrimSpace(req.Header.Get(name))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.Region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.SecretKey), day)
+	key = hmacSHA256(key, s.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.AccessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// awsEscape percent-encodes everything but unreserved characters, and also
+// slashes unless they separate path segments
+func awsEscape(value string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+// canonicalQuery encodes a query string the way Signature Version 4 expects
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, k := range keys {
+		values := append([]string(nil), query[k]...)
+		sort.Strings(values)
+		for _, v := range values {
+			parts = append(parts, awsEscape(k, true)+"="+awsEscape(v, true))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// Stat describes the object at key
+func (s *S3Storage) Stat(key string) (ObjectInfo, error) {
+	name := s.objectName(key)
+	res, err := s.request(http.MethodHead, name, nil, nil, nil)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return ObjectInfo{}, s3Error(res, name)
+	}
+	res.Body.Close()
+
+	info := ObjectInfo{Key: key, Size: res.ContentLength}
+	if modified, err := http.ParseTime(res.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = modified
+	}
+	return info, nil
+}
+
+// List pages through the objects under prefix
+func (s *S3Storage) List(prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	query := url.Values{"list-type": {"2"}, "prefix": {s.objectName(prefix)}}
+	for {
+		res, err := s.request(http.MethodGet, "", query, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if res.StatusCode != http.StatusOK {
+			return nil, s3Error(res, s.Bucket)
+		}
+
+		var page struct {
+			Contents []struct {
+				Key          string
+				Size         int64
+				LastModified time.Time
+			}
+			IsTruncated           bool
+			NextContinuationToken string
+		}
+		err = xml.NewDecoder(res.Body).Decode(&page)
+		res.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse listing of %s: %w", s.Name(), err)
+		}
+
+		for _, c := range page.Contents {
+			key := c.Key
+			if s.Prefix != "" {
+				key = strings.TrimPrefix(key, s.Prefix+"/")
+			}
+			objects = append(objects, ObjectInfo{Key: key, Size: c.Size, ModTime: c.LastModified})
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			break
+		}
+		query.Set("continuation-token", page.NextContinuationToken)
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+// Move copies the object server-side and deletes the original. Single copy
+// requests are limited to 5 GiB by S3.
+func (s *S3Storage) Move(from, to string) error {
+	source := "/" + awsEscape(s.Bucket, false) + "/" + awsEscape(s.objectName(from), false)
+	name := s.objectName(to)
+	res, err := s.request(http.MethodPut, name, nil, nil, http.Header{"X-Amz-Copy-Source": {source}})
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return s3Error(res, name)
+	}
+	// Copies can fail after the 200 status has been sent
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || bytes.Contains(data, []byte("<Error>")) {
+		return fmt.Errorf("failed to copy %s to %s: %s", from, name, data)
+	}
+	return s.Remove(from)
+}
+
+// Remove deletes the object at key; removing a missing key is not an error
+func (s *S3Storage) Remove(key string) error {
+	name := s.objectName(key)
+	res, err := s.request(http.MethodDelete, name, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode >= 300 && res.StatusCode != http.StatusNotFound {
+		return s3Error(res, name)
+	}
+	res.Body.Close()
+	return nil
+}
+
+// Open looks up the object's size; data is fetched with range requests as it
+// is read
+func (s *S3Storage) Open(key string) (Object, error) {
+	info, err := s.Stat(key)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Object{store: s, name: s.objectName(key), size: info.Size}, nil
+}
+
+// s3Object reads an object with range requests. Each request streams from
+// its offset to the end of the object and is kept open after a read, so
+// sequential reads, including those of several zip entries read in parallel,
+// continue where they left off instead of issuing a request per read.
+type s3Object struct {
+	store *S3Storage
+	name  string
+	size  int64
+
+	mu   sync.Mutex
+	pos  int64
+	idle []*s3Stream
+}
+
+// s3Stream is an open range request positioned at next
+type s3Stream struct {
+	body io.ReadCloser
+	next int64
+}
+
+func (o *s3Object) Size() int64 {
+	return o.size
+}
+
+// Read reads sequentially from the current position
+func (o *s3Object) Read(p []byte) (int, error) {
+	o.mu.Lock()
+	pos := o.pos
+	o.mu.Unlock()
+
+	n, err := o.ReadAt(p, pos)
+
+	o.mu.Lock()
+	o.pos = pos + int64(n)
+	o.mu.Unlock()
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+// ReadAt reads len(p) bytes at off, reusing an idle stream positioned there
+func (o *s3Object) ReadAt(p []byte, off int64) (int, error) {
+	if off >= o.size {
+		return 0, io.EOF
+	}
+	want := p
+	if remaining := o.size - off; int64(len(want)) > remaining {
+		want = want[:remaining]
+	}
+
+	stream, reused := o.take(off)
+	n, err := o.fill(stream, want, off)
+	if err != nil && reused {
+		// Idle streams can be closed by the server or the read timeout
+		// while they wait; retry once on a fresh one
+		n, err = o.fill(nil, want, off)
+	}
+	if err != nil {
+		return n, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// fill reads exactly len(p) bytes at off, opening a stream when none is given,
+// and parks the stream for reuse afterwards
+func (o *s3Object) fill(stream *s3Stream, p []byte, off int64) (int, error) {
+	if stream == nil {
+		header := http.Header{"Range": {fmt.Sprintf("bytes=%d-", off)}}
+		res, err := o.store.request(http.MethodGet, o.name, nil, nil, header)
+		if err != nil {
+			return 0, err
+		}
+		if res.StatusCode != http.StatusPartialContent && !(res.StatusCode == http.StatusOK && off == 0) {
+			return 0, s3Error(res, o.name)
+		}
+		stream = &s3Stream{body: res.Body, next: off}
+	}
+
+	n, err := io.ReadFull(stream.body, p)
+	stream.next += int64(n)
+	if err != nil {
+		stream.body.Close()
+		return n, fmt.Errorf("failed to read %s: %w", o.name, err)
+	}
+	o.park(stream)
+	return n, nil
+}
+
+// take removes an idle stream positioned at off
+func (o *s3Object) take(off int64) (*s3Stream, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for i, stream := range o.idle {
+		if stream.next == off {
+			o.idle = append(o.idle[:i], o.idle[i+1:]...)
+			return stream, true
+		}
+	}
+	return nil, false
+}
+
+// park keeps a stream for reuse, closing the oldest beyond the limit or one
+// that has reached the end of the object
+func (o *s3Object) park(stream *s3Stream) {
+	if stream.next >= o.size {
+		stream.body.Close()
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.idle = append(o.idle, stream)
+	if len(o.idle) > s3IdleStreams {
+		o.idle[0].body.Close()
+		o.idle = o.idle[1:]
+	}
+}
+
+// Close ends every idle range request
+func (o *s3Object) Close() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, stream := range o.idle {
+		stream.body.Close()
+	}
+	o.idle = nil
+	return nil
+}
+
+// Create streams to key. Small objects are sent with a single PUT on Close;
+// larger ones become a multipart upload sent one part at a time as the data
+// arrives, so memory use stays at one part.
+func (s *S3Storage) Create(key string) (Writer, error) {
+	return &s3Writer{store: s, name: s.objectName(key)}, nil
+}
+
+// s3Writer buffers one part of a streamed upload
+type s3Writer struct {
+	store    *S3Storage
+	name     string
+	buf      bytes.Buffer
+	uploadID string
+	parts    []s3Part
+	err      error
+}
+
+// s3Part is an uploaded part as listed in the completion request
+type s3Part struct {
+	PartNumber int
+	ETag       string
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	if w.err != nil {
+		return 0, w.err
+	}
+	w.buf.Write(p)
+	for w.buf.Len() >= s3PartSize {
+		if err := w.uploadPart(w.buf.Next(s3PartSize)); err != nil {
+			w.err = err
+			w.abort()
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// uploadPart sends the next part, starting the multipart upload if needed
+func (w *s3Writer) uploadPart(data []byte) error {
+	if w.uploadID == "" {
+		res, err := w.store.request(http.MethodPost, w.name, url.Values{"uploads": {""}}, nil, nil)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			return s3Error(res, w.name)
+		}
+		var started struct{ UploadId string }
+		err = xml.NewDecoder(res.Body).Decode(&started)
+		res.Body.Close()
+		if err != nil || started.UploadId == "" {
+			return fmt.Errorf("failed to start upload of %s: %v", w.name, err)
+		}
+		w.uploadID = started.UploadId
+	}
+
+	number := len(w.parts) + 1
+	query := url.Values{"partNumber": {strconv.Itoa(number)}, "uploadId": {w.uploadID}}
+	res, err := w.store.request(http.MethodPut, w.name, query, data, nil)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return s3Error(res, w.name)
+	}
+	res.Body.Close()
+	w.parts = append(w.parts, s3Part{PartNumber: number, ETag: res.Header.Get("ETag")})
+	return nil
+}
+
+// abort discards the parts of an unfinished multipart upload
+func (w *s3Writer) abort() {
+	if w.uploadID == "" {
+		return
+	}
+	if res, err := w.store.request(http.MethodDelete, w.name, url.Values{"uploadId": {w.uploadID}}, nil, nil); err == nil {
+		res.Body.Close()
+	}
+	w.uploadID = ""
+}
+
+// Abort discards the upload without sending it, so the object at the key,
+// if any, is left as it was
+func (w *s3Writer) Abort() error {
+	w.err = fmt.Errorf("%s: write after abort", w.name)
+	w.buf.Reset()
+	w.abort()
+	return nil
+}
+
+// Close sends what is left and makes the object visible
+func (w *s3Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("%s: write after close", w.name)
+
+	if w.uploadID == "" {
+		res, err := w.store.request(http.MethodPut, w.name, nil, w.buf.Bytes(), nil)
+		if err != nil {
+			return err
+		}
+		if res.StatusCode != http.StatusOK {
+			return s3Error(res, w.name)
+		}
+		res.Body.Close()
+		return nil
+	}
+
+	if w.buf.Len() > 0 {
+		if err := w.uploadPart(w.buf.Bytes()); err != nil {
+			w.abort()
+			return err
+		}
+	}
+
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name `xml:"CompleteMultipartUpload"`
+		Parts   []s3Part `xml:"Part"`
+	}{Parts: w.parts})
+	if err != nil {
+		w.abort()
+		return fmt.Errorf("failed to encode upload of %s: %w", w.name, err)
+	}
+	res, err := w.store.request(http.MethodPost, w.name, url.Values{"uploadId": {w.uploadID}}, body, nil)
+	if err != nil {
+		w.abort()
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		w.abort()
+		return s3Error(res, w.name)
+	}
+	// Completion can fail after the 200 status has been sent
+	data, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || bytes.Contains(data, []byte("<Error>")) {
+		w.abort()
+		return fmt.Errorf("failed to complete upload of %s: %s", w.name, data)
+	}
+	return nil
+}
@@ -1,79 +1,153 @@
 package main
 
 import (
+	"archive/zip"
 	"encoding/xml"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync/atomic"
 )
 
 type EINScanner struct {
-	processed int
-	found     int
-	errors    int
+	processed atomic.Int64
+	found     atomic.Int64
+	errors    atomic.Int64
 	targetEIN string
+	status    string // Pub 78 / revocation status of the target, if known
 }
 
-func main() {
+const defaultScanEIN = "921844425"
+
+// ScanAllEINs searches the filings for targetEIN, reading XML straight from
+// the zip archives and falling back to extracted directories that have no zip.
+// When the filing index is available only the filings it lists for the EIN
+// are opened; walk forces a full scan, which also finds the EIN outside the
+// filer header.
+func ScanAllEINs(targetEIN string, walk bool) error {
 	scanner := &EINScanner{
-		targetEIN: "921844425",
+		targetEIN: normalizeEIN(targetEIN),
 	}
 	
-	dir := "data/990_zips"
+	if eligibility, err := LoadEligibilityTable(eligibilityDir); err == nil {
+		scanner.status = eligibility.Describe(scanner.targetEIN)
+		fmt.Printf("EIN %s deductibility status: %s\n", scanner.targetEIN, scanner.status)
+	}
 	
-	fmt.Printf("Scanning for EIN %s in all directories under %s...\n", scanner.targetEIN, dir)
+	dir := zipBaseDir
 	
-	// Walk through all XML files in all subdirectories
-	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+	index, err := LoadFilingIndex(filepath.Join(indexDir, filingIndexCSV))
+	if err == nil && !walk {
+		records := index.ByEIN(scanner.targetEIN)
+		archives, paths := locateFilings(records, dir)
+		fmt.Printf("Filing index lists %d filings for EIN %s\n", len(records), scanner.targetEIN)
+		for zipPath, only := range archives {
+			if err := scanner.scanArchive(zipPath, only); err != nil {
+				log.Printf("Error scanning %s: %v", zipPath, err)
+			}
+		}
+		for _, path := range paths {
+			scanner.scanPath(path)
+		}
+	} else {
+		fmt.Printf("Scanning for EIN %s in all archives under %s...\n", scanner.targetEIN, dir)
+		
+		entries, err := os.ReadDir(dir)
 		if err != nil {
 			return err
 		}
 		
-		if !info.IsDir() && strings.HasSuffix(strings.ToLower(path), ".xml") {
-			scanner.processed++
-			if scanner.processed%10000 == 0 {
-				fmt.Printf("Processed %d files, found %d matches, %d errors\n", 
-					scanner.processed, scanner.found, scanner.errors)
-			}
-			
-			if err := scanner.scanFile(path); err != nil {
-				scanner.errors++
-				if scanner.processed%1000 == 0 { // Only log errors occasionally to avoid spam
-					log.Printf("Error scanning %s: %v", path, err)
+		zips := make(map[string]bool)
+		for _, entry := range entries {
+			if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+				zips[strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))] = true
+				if err := scanner.scanArchive(filepath.Join(dir, entry.Name()), nil); err != nil {
+					log.Printf("Error scanning %s: %v", entry.Name(), err)
 				}
 			}
 		}
 		
-		return nil
-	})
-	
-	if err != nil {
-		log.Fatal(err)
+		// Walk through the XML files of archives that only exist extracted
+		for _, entry := range entries {
+			if !entry.IsDir() || zips[entry.Name()] {
+				continue
+			}
+			err := filepath.Walk(filepath.Join(dir, entry.Name()), func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				
+				if !info.IsDir() && strings.HasSuffix(strings.ToLower(path), ".xml") {
+					scanner.scanPath(path)
+				}
+				
+				return nil
+			})
+			
+			if err != nil {
+				return err
+			}
+		}
 	}
 	
 	fmt.Printf("\nScan complete!\n")
-	fmt.Printf("Total files processed: %d\n", scanner.processed)
-	fmt.Printf("Total matches for EIN %s: %d\n", scanner.targetEIN, scanner.found)
-	fmt.Printf("Total errors: %d\n", scanner.errors)
+	fmt.Printf("Total files processed: %d\n", scanner.processed.Load())
+	fmt.Printf("Total matches for EIN %s: %d\n", scanner.targetEIN, scanner.found.Load())
+	fmt.Printf("Total errors: %d\n", scanner.errors.Load())
 	
-	if scanner.found == 0 {
+	if scanner.found.Load() == 0 {
 		fmt.Printf("\n❌ EIN %s was NOT found in any of the XML files.\n", scanner.targetEIN)
 	} else {
-		fmt.Printf("\n✅ EIN %s was found %d times!\n", scanner.targetEIN, scanner.found)
+		fmt.Printf("\n✅ EIN %s was found %d times!\n", scanner.targetEIN, scanner.found.Load())
 	}
+	return nil
 }
 
-func (s *EINScanner) scanFile(filepath string) error {
-	file, err := os.Open(filepath)
-	if err != nil {
-		return err
+// scanArchive scans the XML entries of a zip archive concurrently
+func (s *EINScanner) scanArchive(zipPath string, only map[string]bool) error {
+	archive := filepath.Base(zipPath)
+	return forEachZipXML(zipPath, runtime.NumCPU()*2, only, func(entry *zip.File) {
+		s.scan(archive+":"+entry.Name, func() (io.ReadCloser, error) {
+			return entry.Open()
+		})
+	})
+}
+
+// scanPath scans one extracted file
+func (s *EINScanner) scanPath(path string) {
+	s.scan(path, func() (io.ReadCloser, error) {
+		return os.Open(path)
+	})
+}
+
+// scan opens and scans one filing and keeps the running totals. The label
+// names the file, or the archive and entry path it was read from.
+func (s *EINScanner) scan(label string, open func() (io.ReadCloser, error)) {
+	processed := s.processed.Add(1)
+	if processed%10000 == 0 {
+		fmt.Printf("Processed %d files, found %d matches, %d errors\n", 
+			processed, s.found.Load(), s.errors.Load())
 	}
-	defer file.Close()
 	
-	decoder := xml.NewDecoder(file)
+	file, err := open()
+	if err == nil {
+		err = s.scanReader(file, label)
+		file.Close()
+	}
+	if err != nil {
+		s.errors.Add(1)
+		if processed%1000 == 0 { // Only log errors occasionally to avoid spam
+			log.Printf("Error scanning %s: %v", label, err)
+		}
+	}
+}
+
+func (s *EINScanner) scanReader(r io.Reader, label string) error {
+	decoder := xml.NewDecoder(r)
 	
 	for {
 		token, err := decoder.Token()
@@ -94,12 +168,16 @@ func (s *EINScanner) scanFile(filepath string) error {
 				
 				// Check if this EIN matches our target
 				if ein == s.targetEIN {
-					s.found++
-					fmt.Printf("🎯 FOUND EIN %s in file: %s\n", ein, filepath)
+					s.found.Add(1)
+					if s.status != "" {
+						fmt.Printf("🎯 FOUND EIN %s in file: %s [%s]\n", ein, label, s.status)
+					} else {
+						fmt.Printf("🎯 FOUND EIN %s in file: %s\n", ein, label)
+					}
 				}
 			}
 		}
 	}
 	
 	return nil
-} 
\ No newline at end of file
+}
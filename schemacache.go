@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	xsdCacheDir = "./data/990_xsd"
+	// schemaMarker sits in each cached version directory once it is complete
+	schemaMarker = ".schema.json"
+)
+
+// cacheKey names the version's directories: 2023v4.0 for the 990 series,
+// 2023v4.0_990T otherwise
+func (v *SchemaVersion) cacheKey() string {
+	key := v.Version
+	if v.Family != defaultSchemaFamily {
+		key += "_" + v.Family
+	}
+	return key
+}
+
+// CacheDir is where the version's schema package is unpacked:
+// data/990_xsd/2023v4.0 for the 990 series, data/990_xsd/2023v4.0_990T otherwise
+func (v *SchemaVersion) CacheDir() string {
+	return filepath.Join(xsdCacheDir, v.cacheKey())
+}
+
+// TemplatesDir is where xsd2go writes the version's models, one directory per
+// schema document: data/990_xsd/output/2023v4.0
+func (v *SchemaVersion) TemplatesDir() string {
+	return filepath.Join(xsdCacheDir, "output", v.cacheKey())
+}
+
+// Cached reports whether the version's package is unpacked in the cache and,
+// when its hash is known, whether the cached copy came from the same archive
+func (v *SchemaVersion) Cached() bool {
+	data, err := os.ReadFile(filepath.Join(v.CacheDir(), schemaMarker))
+	if err != nil {
+		return false
+	}
+
+	var cached SchemaVersion
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return false
+	}
+	return v.SHA256 == "" || cached.SHA256 == v.SHA256
+}
+
+// cacheSchema fetches a version's archive and unpacks it into its cache
+// directory. The package is assembled next to the cache and renamed into place,
+// so an interrupted run never leaves a half-filled version behind. An archive
+// downloaded by older releases into data/990_xsd is reused instead of fetched.
+func cacheSchema(src Source, v *SchemaVersion) error {
+	dir := v.CacheDir()
+	tmp := dir + ".tmp"
+	if err := os.RemoveAll(tmp); err != nil {
+		return fmt.Errorf("failed to clear %s: %w", tmp, err)
+	}
+	if err := os.MkdirAll(tmp, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	archive := filepath.Join(tmp, v.File)
+	legacy := filepath.Join(xsdCacheDir, v.File)
+	if _, err := os.Stat(legacy); err == nil {
+		fmt.Printf("Caching %s from %s\n", v.Version, legacy)
+		if err := os.Rename(legacy, archive); err != nil {
+			return fmt.Errorf("failed to move %s into the cache: %w", legacy, err)
+		}
+	} else {
+		fmt.Printf("Fetching %s from %s\n", v.Version, v.URL)
+		lastModified, err := copyRemote(src, v.URL, archive)
+		if err != nil {
+			return err
+		}
+		v.ReleaseDate = releaseDate(lastModified)
+	}
+
+	sum, _, err := hashFile(archive)
+	if err != nil {
+		return err
+	}
+	v.SHA256 = sum
+
+	if err := unzipSchema(archive, tmp); err != nil {
+		return fmt.Errorf("failed to unpack %s: %w", v.File, err)
+	}
+
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode schema marker: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(tmp, schemaMarker), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write schema marker: %w", err)
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", dir, err)
+	}
+	return os.Rename(tmp, dir)
+}
+
+// SchemaDir returns the cached package a filing with the given returnVersion
+// attribute was filed under
+func (r *SchemaRegistry) SchemaDir(returnVersion, family string) (string, error) {
+	year, major, minor, err := ParseSchemaVersion(returnVersion)
+	if err != nil {
+		return "", err
+	}
+	if family == "" {
+		family = defaultSchemaFamily
+	}
+
+	v := r.Lookup(fmt.Sprintf("%dv%d.%d", year, major, minor), family)
+	if v == nil {
+		return "", fmt.Errorf("schema version %s (%s) is not registered", returnVersion, family)
+	}
+	if !v.Cached() {
+		return "", fmt.Errorf("schema version %s (%s) is not cached; run the schemas command", v.Version, family)
+	}
+	return v.CacheDir(), nil
+}
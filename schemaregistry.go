@@ -0,0 +1,277 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	schemaRegistryPath = "./data/schema_registry.json"
+	// defaultSchemaFamily is the 990 series (990, 990-EZ, 990-PF) that shares one schema package
+	defaultSchemaFamily = "990"
+	// priorTaxYears is how many earlier tax years MeF accepts under a processing year's schemas
+	priorTaxYears = 2
+)
+
+var (
+	// 2021v4.10: tax year, then major and minor revision
+	schemaVersionPattern = regexp.MustCompile(`(\d{4})v(\d+)\.(\d+)`)
+	schemaFamilyPattern  = regexp.MustCompile(`(?i)990[-_ ]?(EZ|PF|T|N|X)?`)
+)
+
+// SchemaVersion is one published release of the IRS e-file schemas
+type SchemaVersion struct {
+	Version     string     `json:"version"`
+	Year        int        `json:"year"`
+	Major       int        `json:"major"`
+	Minor       int        `json:"minor"`
+	Family      string     `json:"family"`
+	File        string     `json:"file"`
+	URL         string     `json:"url"`
+	ReleaseDate *time.Time `json:"release_date,omitempty"`
+	TaxYears    []int      `json:"tax_years"`
+	SHA256      string     `json:"sha256,omitempty"`
+	FirstSeen   time.Time  `json:"first_seen"`
+}
+
+// SchemaRegistry records every schema version seen on the IRS schemas page
+type SchemaRegistry struct {
+	path     string
+	Versions []*SchemaVersion `json:"versions"`
+}
+
+// ParseSchemaVersion reads a version such as "2021v4.10", as found in schema
+// archive names and in the returnVersion attribute of filings
+func ParseSchemaVersion(value string) (year, major, minor int, err error) {
+	m := schemaVersionPattern.FindStringSubmatch(value)
+	if m == nil {
+		return 0, 0, 0, fmt.Errorf("%q has no schema version", value)
+	}
+	year, _ = strconv.Atoi(m[1])
+	major, _ = strconv.Atoi(m[2])
+	minor, _ = strconv.Atoi(m[3])
+	return year, major, minor, nil
+}
+
+// ParseSchemaArchive describes a schema archive from its download URL
+func ParseSchemaArchive(link string) (*SchemaVersion, error) {
+	file := extractFilenameFromURL(link)
+	year, major, minor, err := ParseSchemaVersion(file)
+	if err != nil {
+		return nil, err
+	}
+
+	family := schemaFamily(file)
+
+	taxYears := make([]int, 0, priorTaxYears+1)
+	for y := year - priorTaxYears; y <= year; y++ {
+		taxYears = append(taxYears, y)
+	}
+
+	return &SchemaVersion{
+		Version:  fmt.Sprintf("%dv%d.%d", year, major, minor),
+		Year:     year,
+		Major:    major,
+		Minor:    minor,
+		Family:   family,
+		File:     file,
+		URL:      link,
+		TaxYears: taxYears,
+	}, nil
+}
+
+// schemaFamily reads the form family from a schema archive name or a
+// ReturnTypeCd such as 990EZ. The 990 series shares one package, named 990x.
+func schemaFamily(name string) string {
+	family := defaultSchemaFamily
+	if m := schemaFamilyPattern.FindStringSubmatch(name); m != nil {
+		if suffix := strings.ToUpper(m[1]); suffix != "" && suffix != "X" {
+			family += suffix
+		}
+	}
+	return family
+}
+
+// Newer reports whether v is a later release than other
+func (v *SchemaVersion) Newer(other *SchemaVersion) bool {
+	if v.Year != other.Year {
+		return v.Year > other.Year
+	}
+	if v.Major != other.Major {
+		return v.Major > other.Major
+	}
+	return v.Minor > other.Minor
+}
+
+// Covers reports whether filings for taxYear may use this version
+func (v *SchemaVersion) Covers(taxYear int) bool {
+	for _, y := range v.TaxYears {
+		if y == taxYear {
+			return true
+		}
+	}
+	return false
+}
+
+// LoadSchemaRegistry reads the registry, returning an empty one if it doesn't exist yet
+func LoadSchemaRegistry(path string) (*SchemaRegistry, error) {
+	registry := &SchemaRegistry{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return registry, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema registry: %w", err)
+	}
+	if err := json.Unmarshal(data, registry); err != nil {
+		return nil, fmt.Errorf("failed to parse schema registry: %w", err)
+	}
+	registry.sort()
+	return registry, nil
+}
+
+// Save writes the registry atomically
+func (r *SchemaRegistry) Save() error {
+	r.sort()
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode schema registry: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write schema registry: %w", err)
+	}
+	return os.Rename(tmp, r.path)
+}
+
+// Register records a version, keeping the first-seen time and filling in
+// details such as the release date on versions already known. It reports
+// whether the version is new.
+func (r *SchemaRegistry) Register(v *SchemaVersion) bool {
+	if existing := r.Lookup(v.Version, v.Family); existing != nil {
+		existing.File = v.File
+		existing.URL = v.URL
+		if v.ReleaseDate != nil {
+			existing.ReleaseDate = v.ReleaseDate
+		}
+		if v.SHA256 != "" {
+			existing.SHA256 = v.SHA256
+		}
+		return false
+	}
+
+	if v.FirstSeen.IsZero() {
+		v.FirstSeen = time.Now().UTC()
+	}
+	r.Versions = append(r.Versions, v)
+	r.sort()
+	return true
+}
+
+// Lookup returns a version of a form family, or nil if it isn't registered
+func (r *SchemaRegistry) Lookup(version, family string) *SchemaVersion {
+	for _, v := range r.Versions {
+		if v.Version == version && strings.EqualFold(v.Family, family) {
+			return v
+		}
+	}
+	return nil
+}
+
+// ForTaxYear returns the versions filings for taxYear may use, newest first.
+// An empty family matches every family.
+func (r *SchemaRegistry) ForTaxYear(taxYear int, family string) []*SchemaVersion {
+	var versions []*SchemaVersion
+	for _, v := range r.Versions {
+		if v.Covers(taxYear) && (family == "" || strings.EqualFold(v.Family, family)) {
+			versions = append(versions, v)
+		}
+	}
+	return versions
+}
+
+// LatestForTaxYear returns the newest version released for taxYear itself,
+// falling back to the newest version that accepts it
+func (r *SchemaRegistry) LatestForTaxYear(taxYear int, family string) *SchemaVersion {
+	versions := r.ForTaxYear(taxYear, family)
+	for _, v := range versions {
+		if v.Year == taxYear {
+			return v
+		}
+	}
+	if len(versions) > 0 {
+		return versions[0]
+	}
+	return nil
+}
+
+// Latest returns the newest version of a form family, or nil if none is registered
+func (r *SchemaRegistry) Latest(family string) *SchemaVersion {
+	for _, v := range r.Versions {
+		if family == "" || strings.EqualFold(v.Family, family) {
+			return v
+		}
+	}
+	return nil
+}
+
+// sort orders versions newest first
+func (r *SchemaRegistry) sort() {
+	sort.SliceStable(r.Versions, func(i, j int) bool {
+		a, b := r.Versions[i], r.Versions[j]
+		if a.Newer(b) || b.Newer(a) {
+			return a.Newer(b)
+		}
+		return a.Family < b.Family
+	})
+}
+
+// PrintSchemaVersions lists versions as a table
+func PrintSchemaVersions(w io.Writer, versions []*SchemaVersion) {
+	if len(versions) == 0 {
+		fmt.Fprintln(w, "No schema versions registered")
+		return
+	}
+
+	fmt.Fprintf(w, "%-12s %-7s %-11s %-15s %s\n", "VERSION", "FAMILY", "RELEASED", "TAX YEARS", "URL")
+	for _, v := range versions {
+		released := "unknown"
+		if v.ReleaseDate != nil {
+			released = v.ReleaseDate.Format("2006-01-02")
+		}
+		taxYears := ""
+		if len(v.TaxYears) > 0 {
+			taxYears = formatRange(v.TaxYears[0], v.TaxYears[len(v.TaxYears)-1])
+		}
+		fmt.Fprintf(w, "%-12s %-7s %-11s %-15s %s\n", v.Version, v.Family, released, taxYears, v.URL)
+	}
+}
+
+// releaseDate reads a Last-Modified value as the release date
+func releaseDate(lastModified string) *time.Time {
+	if lastModified == "" {
+		return nil
+	}
+	when, err := http.ParseTime(lastModified)
+	if err != nil {
+		return nil
+	}
+	when = when.UTC()
+	return &when
+}
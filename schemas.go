@@ -2,76 +2,63 @@ package main
 
 import (
 	"archive/zip"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/gocomply/xsd2go/pkg/xsd2go"
 )
 
-func UnzipSchemas() error {
-    entries, err := os.ReadDir("./data/990_xsd")
+// unzipSchema unpacks a schema archive into dstRoot
+func unzipSchema(zipPath, dstRoot string) error {
+    archive, err := zip.OpenReader(zipPath)
     if err != nil {
-        return fmt.Errorf("read dir: %w", err)
+        return fmt.Errorf("open zip %q: %w", zipPath, err)
     }
+    defer archive.Close()
 
-    dstRoot := "./data/990_xsd/output"
-    for _, entry := range entries {
-        if entry.IsDir() {
+    for _, f := range archive.File {
+        destPath := filepath.Join(dstRoot, f.Name)
+        // guard against ZipSlip
+        if !strings.HasPrefix(destPath, filepath.Clean(dstRoot)+string(os.PathSeparator)) {
+            return fmt.Errorf("illegal file path: %s", destPath)
+        }
+
+        if f.FileInfo().IsDir() {
+            if err := os.MkdirAll(destPath, os.ModePerm); err != nil {
+                return err
+            }
             continue
         }
-        zipPath := filepath.Join("./data/990_xsd", entry.Name())
-        archive, err := zip.OpenReader(zipPath)
-        if err != nil {
-            return fmt.Errorf("open zip %q: %w", zipPath, err)
+
+        if err := os.MkdirAll(filepath.Dir(destPath), os.ModePerm); err != nil {
+            return err
         }
 
-        // process and then close immediately
         if err := func() error {
-            defer archive.Close()
-
-            for _, f := range archive.File {
-                destPath := filepath.Join(dstRoot, f.Name)
-                // guard against ZipSlip
-                if !strings.HasPrefix(destPath, filepath.Clean(dstRoot)+string(os.PathSeparator)) {
-                    return fmt.Errorf("illegal file path: %s", destPath)
-                }
-
-                if f.FileInfo().IsDir() {
-                    if err := os.MkdirAll(destPath, os.ModePerm); err != nil {
-                        return err
-                    }
-                    continue
-                }
-
-                if err := os.MkdirAll(filepath.Dir(destPath), os.ModePerm); err != nil {
-                    return err
-                }
-
-                outFile, err := os.OpenFile(
-                    destPath,
-                    os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
-                    f.Mode(),
-                )
-                if err != nil {
-                    return err
-                }
-                defer outFile.Close()
-
-                rc, err := f.Open()
-                if err != nil {
-                    return err
-                }
-                defer rc.Close()
-
-                if _, err := io.Copy(outFile, rc); err != nil {
-                    return err
-                }
+            outFile, err := os.OpenFile(
+                destPath,
+                os.O_CREATE|os.O_WRONLY|os.O_TRUNC,
+                f.Mode()|0600,
+            )
+            if err != nil {
+                return err
             }
-            return nil
+            defer outFile.Close()
+
+            rc, err := f.Open()
+            if err != nil {
+                return err
+            }
+            defer rc.Close()
+
+            _, err = io.Copy(outFile, rc)
+            return err
         }(); err != nil {
             return err
         }
@@ -79,10 +66,19 @@ func UnzipSchemas() error {
     return nil
 }
 
-func GlobWalk(rootDir, pattern string) ([]string, error) {
+// GlobWalk runs xsd2go over every file under rootDir matching pattern,
+// writing the models into outDir. It returns the files converted; a file
+// that fails does not stop the others, and the failures are returned
+// together.
+func GlobWalk(rootDir, pattern, outDir string) ([]string, error) {
     var matches []string
+    var failed []error
+    outDir, err := filepath.Abs(outDir)
+    if err != nil {
+        return nil, err
+    }
 
-    err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, walkErr error) error {
+    err = filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, walkErr error) error {
         if walkErr != nil {
             return walkErr
         }
@@ -97,30 +93,10 @@ func GlobWalk(rootDir, pattern string) ([]string, error) {
             return nil
         }
 
-        // === Begin per‐file conversion logic ===
-        // Remember current working dir
-        cwd, err := os.Getwd()
-        if err != nil {
-            return err
-        }
-        // Switch into the XSD’s directory so includes resolve
-        schemaDir := filepath.Dir(path)
-        if err := os.Chdir(schemaDir); err != nil {
-            return fmt.Errorf("chdir to %q: %w", schemaDir, err)
-        }
-        // Restore cwd when done
-        defer os.Chdir(cwd)
-
-        // Call xsd2go.Convert on the base filename
-        if err := xsd2go.Convert(
-            filepath.Base(path),                     // just the file name now
-            "main",                                   // or whatever package name you want
-            filepath.Join(cwd, "data/990_xsd/output/generated_templates"),
-            nil,
-        ); err != nil {
-            fmt.Errorf("xsd2go failed for %q: %w", path, err)
+        if err := convertSchema(path, outDir); err != nil {
+            failed = append(failed, fmt.Errorf("xsd2go failed for %q: %w", path, err))
+            return nil
         }
-        // === End per‐file conversion logic ===
 
         matches = append(matches, path)
         return nil
@@ -128,59 +104,31 @@ func GlobWalk(rootDir, pattern string) ([]string, error) {
     if err != nil {
         return nil, err
     }
-    return matches, nil
+    return matches, errors.Join(failed...)
 }
 
+// chdirMu serializes the working directory changes of convertSchema
+var chdirMu sync.Mutex
+
+// convertSchema runs xsd2go on one schema from inside the schema's directory,
+// so its relative xs:include locations resolve next to it
+func convertSchema(path, outDir string) (err error) {
+    chdirMu.Lock()
+    defer chdirMu.Unlock()
 
-//func GlobWalk(rootDir, pattern string) ([]string, error) {
-//    var matches []string
-//
-//    // 1) collect all matching files
-//    err := filepath.WalkDir(rootDir, func(path string, d fs.DirEntry, walkErr error) error {
-//        if walkErr != nil {
-//            return walkErr
-//        }
-//        if d.IsDir() {
-//            return nil
-//        }
-//        ok, err := filepath.Match(pattern, filepath.Base(path))
-//        if err != nil {
-//            return err
-//        }
-//        if ok {
-//            matches = append(matches, path)
-//        }
-//        return nil
-//    })
-//    if err != nil {
-//        return nil, err
-//    }
-//
-//    // 2) call Convert once per file
-//    for _, uri := range matches {
-//        if err := xsd2go.Convert(
-//            uri,
-//            "main",                                              // package name
-//            "./data/990_xsd/output/generated_templates",         // output dir
-//            nil,                                                 // no overrides
-//        ); err != nil {
-//            return nil, fmt.Errorf("xsd2go conversion failed for %q: %w", uri, err)
-//        }
-//    }
-//
-//    return matches, nil
-//}
-
-
-
-func SchemaGenerator(uri string) {
-    err := xsd2go.Convert(
-        uri,
-        "github.com/synergos-systems/xsd2go",
-        "./parser/xsd2go",
-        nil,
-    )
+    cwd, err := os.Getwd()
     if err != nil {
-        fmt.Println(err)
+        return err
+    }
+    schemaDir := filepath.Dir(path)
+    if err := os.Chdir(schemaDir); err != nil {
+        return fmt.Errorf("failed to change to %q: %w", schemaDir, err)
     }
+    defer func() {
+        if restoreErr := os.Chdir(cwd); restoreErr != nil && err == nil {
+            err = fmt.Errorf("failed to change back to %q: %w", cwd, restoreErr)
+        }
+    }()
+
+    return xsd2go.Convert(filepath.Base(path), "main", outDir, nil)
 }
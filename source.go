@@ -0,0 +1,374 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	irsDownloadsPage = "https://www.irs.gov/charities-non-profits/form-990-series-downloads"
+	irsSchemasPage   = "https://www.irs.gov/charities-non-profits/tax-exempt-organization-search-teos-schemas"
+	irsXMLBase       = "https://apps.irs.gov/pub/epostcard/990/xml/"
+)
+
+// ErrNotFound is returned by a Source when a link does not exist
+var ErrNotFound = errors.New("not found")
+
+// Source serves the IRS download pages and the files they link to. Links are
+// always the canonical IRS URLs; each source decides where they really live.
+//
+// Mirrors use the layout produced by `wget --mirror`: every URL is stored
+// under <root>/<host>/<path>, so the canonical URL
+// https://apps.irs.gov/pub/epostcard/990/xml/2024/2024_TEOS_XML_01A.zip
+// becomes <root>/apps.irs.gov/pub/epostcard/990/xml/2024/2024_TEOS_XML_01A.zip.
+type Source interface {
+	// Name describes the source for log output
+	Name() string
+	// Open returns the contents of link starting at opts.Offset. Sources that
+	// cannot seek start from zero and report it through Remote.Offset.
+	Open(link string, opts OpenOptions) (*Remote, error)
+	// Head describes link without fetching it, returning a Remote with an
+	// empty body, or ErrNotFound when the link does not exist
+	Head(link string) (*Remote, error)
+}
+
+// OpenOptions control how a Source opens a file
+type OpenOptions struct {
+	Offset int64
+	// ETag and LastModified make the request conditional: when the file
+	// still matches them, Open returns a Remote with NotModified set
+	ETag         string
+	LastModified string
+	// IfRange is the ETag or Last-Modified of the file the bytes before
+	// Offset came from. When the file no longer matches it, Open starts
+	// from zero instead.
+	IfRange string
+}
+
+// Remote is an open file from a Source
+type Remote struct {
+	Body         io.ReadCloser
+	Offset       int64 // position of the first byte of Body
+	Size         int64 // total size of the file, or -1 if unknown
+	ETag         string
+	LastModified string
+	NotModified  bool
+}
+
+// ParseSource builds a Source from a command line value: "irs" for the live
+// site, an http(s) base URL for a mirror server, or a mirror directory.
+// Remote sources send their requests through client.
+func ParseSource(spec string, client *Client) (Source, error) {
+	switch {
+	case spec == "" || spec == "irs":
+		return &HTTPSource{Client: client}, nil
+	case strings.HasPrefix(spec, "http://") || strings.HasPrefix(spec, "https://"):
+		base, err := url.Parse(strings.TrimSuffix(spec, "/"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid source URL %q: %w", spec, err)
+		}
+		return &HTTPSource{Base: base, Client: client}, nil
+	default:
+		root := strings.TrimPrefix(spec, "file://")
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source directory: %w", err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("invalid source directory: %s is not a directory", root)
+		}
+		return &DirSource{Root: root}, nil
+	}
+}
+
+// HTTPSource fetches from the live IRS site, or from a server that hosts a
+// mirror when Base is set
+type HTTPSource struct {
+	Base   *url.URL
+	Client *Client
+}
+
+// Name describes the source for log output
+func (s *HTTPSource) Name() string {
+	if s.Base == nil {
+		return "irs.gov"
+	}
+	return s.Base.String()
+}
+
+// resolve maps a canonical IRS URL onto the mirror server
+func (s *HTTPSource) resolve(link string) (string, error) {
+	if s.Base == nil {
+		return link, nil
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid link %q: %w", link, err)
+	}
+
+	mirrored := *s.Base
+	mirrored.Path = strings.TrimSuffix(s.Base.Path, "/") + "/" + u.Host + u.Path
+	mirrored.RawQuery = u.RawQuery
+	return mirrored.String(), nil
+}
+
+// Open requests link, asking for a byte range when the offset is positive
+func (s *HTTPSource) Open(link string, opts OpenOptions) (*Remote, error) {
+	target, err := s.resolve(link)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	offset := opts.Offset
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		if opts.IfRange != "" {
+			// A changed file is sent whole, with a 200 status
+			req.Header.Set("If-Range", opts.IfRange)
+		}
+	}
+	if opts.ETag != "" {
+		req.Header.Set("If-None-Match", opts.ETag)
+	}
+	if opts.LastModified != "" {
+		req.Header.Set("If-Modified-Since", opts.LastModified)
+	}
+
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", target, err)
+	}
+
+	remote := &Remote{
+		Body:         res.Body,
+		Size:         res.ContentLength,
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return remote, nil
+	case http.StatusNotModified:
+		res.Body.Close()
+		remote.Body = http.NoBody
+		remote.Size = -1
+		remote.NotModified = true
+		return remote, nil
+	case http.StatusPartialContent:
+		start, size, ok := parseContentRange(res.Header.Get("Content-Range"))
+		if !ok || start != offset {
+			res.Body.Close()
+			return nil, fmt.Errorf("unexpected Content-Range %q for offset %d", res.Header.Get("Content-Range"), offset)
+		}
+		remote.Offset = start
+		remote.Size = size
+		return remote, nil
+	case http.StatusRequestedRangeNotSatisfiable:
+		res.Body.Close()
+		// The caller already holds the whole file when the offset equals
+		// the size; otherwise the range is stale and we start over
+		if _, size, ok := parseContentRange(res.Header.Get("Content-Range")); ok && size == offset {
+			remote.Body = http.NoBody
+			remote.Offset = offset
+			remote.Size = size
+			return remote, nil
+		}
+		opts.Offset = 0
+		return s.Open(link, opts)
+	case http.StatusNotFound, http.StatusGone:
+		res.Body.Close()
+		return nil, fmt.Errorf("%s: %w", target, ErrNotFound)
+	default:
+		res.Body.Close()
+		return nil, fmt.Errorf("HTTP error fetching %s: %d", target, res.StatusCode)
+	}
+}
+
+// Head sends a HEAD request for link. Servers that refuse HEAD are asked for
+// the file instead, and the body is discarded.
+func (s *HTTPSource) Head(link string) (*Remote, error) {
+	target, err := s.resolve(link)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodHead, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	res, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe %s: %w", target, err)
+	}
+	res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return &Remote{
+			Body:         http.NoBody,
+			Size:         res.ContentLength,
+			ETag:         res.Header.Get("ETag"),
+			LastModified: res.Header.Get("Last-Modified"),
+		}, nil
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented:
+		remote, err := s.Open(link, OpenOptions{})
+		if err != nil {
+			return nil, err
+		}
+		remote.Body.Close()
+		remote.Body = http.NoBody
+		return remote, nil
+	case http.StatusNotFound, http.StatusGone:
+		return nil, fmt.Errorf("%s: %w", target, ErrNotFound)
+	default:
+		return nil, fmt.Errorf("HTTP error probing %s: %d", target, res.StatusCode)
+	}
+}
+
+// DirSource reads from a mirror directory on local disk
+type DirSource struct {
+	Root string
+}
+
+// Name describes the source for log output
+func (s *DirSource) Name() string {
+	return s.Root
+}
+
+// resolve maps a canonical IRS URL onto the mirror directory
+func (s *DirSource) resolve(link string) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", fmt.Errorf("invalid link %q: %w", link, err)
+	}
+
+	path := filepath.Join(s.Root, u.Host, filepath.FromSlash(u.Path))
+	if !strings.HasPrefix(path, filepath.Clean(s.Root)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("illegal link: %s", link)
+	}
+
+	// wget stores pages without an extension as-is, but directory
+	// listings and some pages end up as index.html or <name>.html
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return filepath.Join(path, "index.html"), nil
+	} else if err != nil {
+		if _, htmlErr := os.Stat(path + ".html"); htmlErr == nil {
+			return path + ".html", nil
+		}
+	}
+	return path, nil
+}
+
+// Open opens the mirrored copy of link and seeks to the offset. Mirrors have
+// no ETags, so conditional opens compare against the file's modification time.
+func (s *DirSource) Open(link string, opts OpenOptions) (*Remote, error) {
+	path, err := s.resolve(link)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", path, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mirrored file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat mirrored file: %w", err)
+	}
+
+	lastModified := info.ModTime().UTC().Format(http.TimeFormat)
+	if opts.LastModified != "" {
+		if since, err := http.ParseTime(opts.LastModified); err == nil && !info.ModTime().Truncate(time.Second).After(since) {
+			file.Close()
+			return &Remote{Body: http.NoBody, Size: -1, LastModified: lastModified, NotModified: true}, nil
+		}
+	}
+
+	offset := opts.Offset
+	if offset > info.Size() || opts.IfRange != "" && opts.IfRange != lastModified {
+		offset = 0
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to seek mirrored file: %w", err)
+	}
+
+	return &Remote{Body: file, Offset: offset, Size: info.Size(), LastModified: lastModified}, nil
+}
+
+// Head describes the mirrored copy of link
+func (s *DirSource) Head(link string) (*Remote, error) {
+	path, err := s.resolve(link)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", path, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat mirrored file: %w", err)
+	}
+	return &Remote{Body: http.NoBody, Size: info.Size(), LastModified: info.ModTime().UTC().Format(http.TimeFormat)}, nil
+}
+
+// pageLinks fetches an HTML page from src and returns every link whose target
+// contains match, resolved to an absolute canonical URL
+func pageLinks(src Source, page, match string) ([]string, error) {
+	remote, err := src.Open(page, OpenOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IRS page: %w", err)
+	}
+	defer remote.Body.Close()
+
+	doc, err := html.Parse(remote.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	base, err := url.Parse(page)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page URL %q: %w", page, err)
+	}
+
+	var links []string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "a" {
+			for _, attr := range n.Attr {
+				if attr.Key == "href" && strings.Contains(attr.Val, match) {
+					if ref, err := base.Parse(attr.Val); err == nil {
+						links = append(links, ref.String())
+					}
+				}
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	return links, nil
+}
@@ -0,0 +1,350 @@
+package main
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Storage holds the archives, extracted filings and outputs of the pipeline.
+// Keys are slash-separated paths relative to the store's root, such as
+// data/990_zips/2024_TEOS_XML_01A.zip or irs_990_data.csv. Missing keys are
+// reported with errors that match fs.ErrNotExist.
+type Storage interface {
+	// Name describes the store for log output
+	Name() string
+	// Open returns the object at key for streaming or random access reads
+	Open(key string) (Object, error)
+	// Create streams a new object to key. It replaces any object already
+	// there once Close returns nil; until then readers see the old one.
+	Create(key string) (Writer, error)
+	Stat(key string) (ObjectInfo, error)
+	// List returns every object whose key starts with prefix, sorted by key
+	List(prefix string) ([]ObjectInfo, error)
+	Remove(key string) error
+}
+
+// Writer is an object being written to a Storage. Close publishes it and is
+// only called once everything was written; Abort discards it instead, leaving
+// any object already at the key untouched.
+type Writer interface {
+	io.WriteCloser
+	Abort() error
+}
+
+// abortWriter discards w if it is a stored object and closes it otherwise
+func abortWriter(w io.WriteCloser) error {
+	if writer, ok := w.(Writer); ok {
+		return writer.Abort()
+	}
+	return w.Close()
+}
+
+// Object is an open object in a Storage. ReadAt lets archive/zip read
+// archives in place, without copying them to local disk first.
+type Object interface {
+	io.Reader
+	io.ReaderAt
+	io.Closer
+	Size() int64
+}
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+// ParseStorage builds a Storage from a command line value: empty for the
+// working directory, a local directory, or s3://bucket/prefix for an
+// S3-compatible object store configured from the environment
+func ParseStorage(spec string) (Storage, error) {
+	switch {
+	case spec == "" || spec == ".":
+		return &LocalStorage{Root: "."}, nil
+	case strings.HasPrefix(spec, "s3://"):
+		return NewS3StorageFromEnv(spec)
+	default:
+		root := strings.TrimPrefix(spec, "file://")
+		if err := os.MkdirAll(root, 0755); err != nil {
+			return nil, fmt.Errorf("invalid storage directory: %w", err)
+		}
+		return &LocalStorage{Root: root}, nil
+	}
+}
+
+// onDisk reports whether store is the working directory, where the stages
+// keep using their file-based paths with resumable downloads and in-place
+// extraction
+func onDisk(store Storage) bool {
+	if store == nil {
+		return true
+	}
+	local, ok := store.(*LocalStorage)
+	return ok && filepath.Clean(local.Root) == "."
+}
+
+// requireLocalStore refuses a store for commands that only work on the
+// archives in the working directory
+func requireLocalStore(command string, store Storage) error {
+	if onDisk(store) {
+		return nil
+	}
+	return fmt.Errorf("%s reads ./data in the working directory and cannot use the store %s", command, store.Name())
+}
+
+// Mover is implemented by stores that can move an object without reading it
+// back, such as a rename on disk or a server-side copy
+type Mover interface {
+	Move(from, to string) error
+}
+
+// moveObject moves an object within a store, copying it through this process
+// when the store cannot move objects itself
+func moveObject(store Storage, from, to string) error {
+	if mover, ok := store.(Mover); ok {
+		return mover.Move(from, to)
+	}
+
+	in, err := store.Open(from)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := store.Create(to)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Abort()
+		return fmt.Errorf("failed to copy %s to %s: %w", from, to, err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to copy %s to %s: %w", from, to, err)
+	}
+	return store.Remove(from)
+}
+
+// hashObject returns the SHA-256 and size of a stored object
+func hashObject(store Storage, key string) (string, int64, error) {
+	object, err := store.Open(key)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to open %s: %w", key, err)
+	}
+	defer object.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, object)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to hash %s: %w", key, err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
+
+// storageKey joins path elements into a key
+func storageKey(elem ...string) string {
+	return path.Join(elem...)
+}
+
+// openZip opens an archive held in store for reading in place
+func openZip(store Storage, key string) (*zip.Reader, io.Closer, error) {
+	object, err := store.Open(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := zip.NewReader(object, object.Size())
+	if err != nil {
+		object.Close()
+		return nil, nil, fmt.Errorf("failed to open ZIP file %s: %w", key, err)
+	}
+	return reader, object, nil
+}
+
+// LocalStorage keeps objects as files under a root directory
+type LocalStorage struct {
+	Root string
+}
+
+// Name describes the store for log output
+func (s *LocalStorage) Name() string {
+	return s.Root
+}
+
+// path maps a key onto the file that holds it. Keys are cleaned as if rooted,
+// so ".." cannot climb out of the root.
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" {
+		return "", fmt.Errorf("illegal key: %q", key)
+	}
+	return filepath.Join(s.Root, filepath.FromSlash(clean)), nil
+}
+
+// localObject is an open file that knows its size
+type localObject struct {
+	*os.File
+	size int64
+}
+
+func (o *localObject) Size() int64 {
+	return o.size
+}
+
+// Open opens the file holding key
+func (s *LocalStorage) Open(key string) (Object, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to stat %s: %w", p, err)
+	}
+	return &localObject{File: file, size: info.Size()}, nil
+}
+
+// localWriter writes to a temporary file that is renamed over the key on Close
+type localWriter struct {
+	*os.File
+	dest string
+}
+
+func (w *localWriter) Close() error {
+	if err := w.File.Close(); err != nil {
+		os.Remove(w.Name())
+		return err
+	}
+	if err := os.Rename(w.Name(), w.dest); err != nil {
+		os.Remove(w.Name())
+		return fmt.Errorf("failed to finalize %s: %w", w.dest, err)
+	}
+	return nil
+}
+
+// Abort removes the temporary file
+func (w *localWriter) Abort() error {
+	w.File.Close()
+	if err := os.Remove(w.Name()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Create starts writing key through a temporary file in the same directory
+func (s *LocalStorage) Create(key string) (Writer, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+	file, err := os.CreateTemp(filepath.Dir(p), filepath.Base(p)+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", p, err)
+	}
+	return &localWriter{File: file, dest: p}, nil
+}
+
+// Stat describes the file holding key
+func (s *LocalStorage) Stat(key string) (ObjectInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return ObjectInfo{}, err
+	}
+	if info.IsDir() {
+		return ObjectInfo{}, fmt.Errorf("%s is a directory: %w", key, fs.ErrNotExist)
+	}
+	return ObjectInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()}, nil
+}
+
+// List walks the directory that prefix falls in
+func (s *LocalStorage) List(prefix string) ([]ObjectInfo, error) {
+	dir := prefix
+	if !strings.HasSuffix(prefix, "/") {
+		dir = path.Dir(prefix)
+	}
+	start := filepath.Join(s.Root, filepath.FromSlash(path.Clean("/"+dir)))
+
+	var objects []ObjectInfo
+	err := filepath.WalkDir(start, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(p, ".tmp") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.Root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		objects = append(objects, ObjectInfo{Key: key, Size: info.Size(), ModTime: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s: %w", prefix, err)
+	}
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+// Move renames the file holding from
+func (s *LocalStorage) Move(from, to string) error {
+	src, err := s.path(from)
+	if err != nil {
+		return err
+	}
+	dst, err := s.path(to)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+	return os.Rename(src, dst)
+}
+
+// Remove deletes the file holding key; removing a missing key is not an error
+func (s *LocalStorage) Remove(key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
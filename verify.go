@@ -0,0 +1,189 @@
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	quarantineDir    = "./data/990_quarantine"
+	quarantineReason = ".reason.txt"
+)
+
+// VerifyResult describes the integrity check of one archive
+type VerifyResult struct {
+	Path    string
+	Entries int
+	Bytes   int64
+	Err     error
+}
+
+// VerifyArchive opens an archive's central directory and reads every entry
+// to the end, which makes archive/zip check its CRC-32
+func VerifyArchive(path string) VerifyResult {
+	result := VerifyResult{Path: path}
+
+	reader, err := zip.OpenReader(path)
+	if err != nil {
+		result.Err = fmt.Errorf("unreadable central directory: %w", err)
+		return result
+	}
+	defer reader.Close()
+
+	verifyEntries(&reader.Reader, &result)
+	return result
+}
+
+// VerifyStoredArchive checks an archive held in a Storage the same way,
+// reading it in place
+func VerifyStoredArchive(store Storage, key string) VerifyResult {
+	result := VerifyResult{Path: key}
+
+	reader, closer, err := openZip(store, key)
+	if err != nil {
+		result.Err = fmt.Errorf("unreadable central directory: %w", err)
+		return result
+	}
+	defer closer.Close()
+
+	verifyEntries(reader, &result)
+	return result
+}
+
+// verifyEntries reads every entry of an open archive into result
+func verifyEntries(reader *zip.Reader, result *VerifyResult) {
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		rc, err := file.Open()
+		if err != nil {
+			result.Err = fmt.Errorf("entry %s: %w", file.Name, err)
+			return
+		}
+
+		n, err := io.Copy(io.Discard, rc)
+		rc.Close()
+		if err != nil {
+			result.Err = fmt.Errorf("entry %s: %w", file.Name, err)
+			return
+		}
+
+		result.Entries++
+		result.Bytes += n
+	}
+}
+
+// verifyDownload is the post-download hook used by sync
+func verifyDownload(path string) error {
+	return VerifyArchive(path).Err
+}
+
+// verifyStoredDownload is the post-download hook used by sync into a Storage
+func verifyStoredDownload(store Storage, key string) error {
+	return VerifyStoredArchive(store, key).Err
+}
+
+// Quarantine moves a corrupt archive into the quarantine directory under
+// name and writes a reason file next to it
+func Quarantine(path, name string, reason error) (string, error) {
+	if err := os.MkdirAll(quarantineDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create quarantine directory: %w", err)
+	}
+
+	dest := filepath.Join(quarantineDir, name)
+	if err := os.Rename(path, dest); err != nil {
+		return "", fmt.Errorf("failed to quarantine %s: %w", path, err)
+	}
+
+	note := fmt.Sprintf("archive: %s\nquarantined: %s\nreason: %v\n", name, time.Now().UTC().Format(time.RFC3339), reason)
+	if err := os.WriteFile(dest+quarantineReason, []byte(note), 0644); err != nil {
+		return dest, fmt.Errorf("failed to write quarantine reason: %w", err)
+	}
+
+	return dest, nil
+}
+
+// releaseQuarantine removes the quarantined copy of an archive and its reason
+// file from store once a good copy has been downloaded
+func releaseQuarantine(store Storage, name string) error {
+	for _, key := range []string{storageKey(quarantineDir, name), storageKey(quarantineDir, name+quarantineReason)} {
+		if err := store.Remove(key); err != nil {
+			return fmt.Errorf("failed to release %s from quarantine: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// VerifyAllZips checks every archive in zipDir in parallel and quarantines the
+// corrupt ones so the next sync downloads them again
+func VerifyAllZips(zipDir string) error {
+	entries, err := os.ReadDir(zipDir)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(strings.ToLower(entry.Name()), ".zip") {
+			paths = append(paths, filepath.Join(zipDir, entry.Name()))
+		}
+	}
+
+	catalog, err := LoadCatalog(catalogPath)
+	if err != nil {
+		return err
+	}
+
+	results := make([]VerifyResult, len(paths))
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, runtime.NumCPU())
+	for i, path := range paths {
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i] = VerifyArchive(path)
+		}(i, path)
+	}
+	wg.Wait()
+
+	var bad []string
+	for _, result := range results {
+		name := filepath.Base(result.Path)
+		if result.Err == nil {
+			fmt.Printf("✓ %s (%d entries, %s)\n", name, result.Entries, formatBytes(result.Bytes))
+			continue
+		}
+
+		fmt.Printf("✗ %s: %v\n", name, result.Err)
+		if _, err := Quarantine(result.Path, name, result.Err); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			continue
+		}
+		catalog.MarkQuarantined(name, "")
+		bad = append(bad, name)
+	}
+
+	if err := catalog.Save(); err != nil {
+		return err
+	}
+
+	sort.Strings(bad)
+	fmt.Printf("Verified %d archives, quarantined %d.\n", len(paths), len(bad))
+	if len(bad) > 0 {
+		fmt.Println("Run sync to download the quarantined archives again.")
+	}
+	return nil
+}
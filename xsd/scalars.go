@@ -0,0 +1,342 @@
+package xsd
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrOverflow reports an amount beyond the range of an int64
+var ErrOverflow = errors.New("amount overflows int64")
+
+// Checkbox is an IRS checkbox, which is either checked, written as X, or
+// left out. An unchecked box writes nothing.
+type Checkbox bool
+
+// Boolean is an xs:boolean, read from any of its forms: true, false, 1 and 0
+type Boolean bool
+
+// Amount is a whole-dollar amount. Reading one, and Add and Sub, fail with
+// ErrOverflow rather than wrap.
+type Amount int64
+
+// Date is an xs:date such as 2023-12-31, which may carry a time zone. A
+// zero Date writes nothing.
+type Date struct {
+	time.Time
+	// zoned is set when the value names its time zone, so it is written back with it
+	zoned bool
+}
+
+// Timestamp is an xs:dateTime such as 2024-05-15T10:30:00-05:00. A zero
+// Timestamp writes nothing.
+type Timestamp struct {
+	time.Time
+	zoned bool
+}
+
+// Year is an xs:gYear such as 2023, which may carry a time zone. A zero Year
+// writes nothing.
+type Year struct {
+	time.Time
+	zoned bool
+}
+
+const (
+	dateLayout      = "2006-01-02"
+	timestampLayout = "2006-01-02T15:04:05.999999999"
+	yearLayout      = "2006"
+	zoneLayout      = "Z07:00"
+)
+
+// ParseCheckbox reads a checkbox, which is X when checked. Empty text leaves
+// it unchecked.
+func ParseCheckbox(text string) (Checkbox, error) {
+	switch strings.TrimSpace(text) {
+	case "X":
+		return true, nil
+	case "":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not X", text)
+}
+
+// ParseBoolean reads an xs:boolean
+func ParseBoolean(text string) (Boolean, error) {
+	switch strings.TrimSpace(text) {
+	case "true", "1":
+		return true, nil
+	case "false", "0":
+		return false, nil
+	}
+	return false, fmt.Errorf("%q is not a boolean", text)
+}
+
+// ParseAmount reads an xs:integer amount such as -1250 or +300
+func ParseAmount(text string) (Amount, error) {
+	value, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	if errors.Is(err, strconv.ErrRange) {
+		return 0, fmt.Errorf("%q: %w", text, ErrOverflow)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a whole amount", text)
+	}
+	return Amount(value), nil
+}
+
+// ParseDate reads an xs:date. Without a time zone the date is in UTC.
+func ParseDate(text string) (Date, error) {
+	t, zoned, err := parseTime(text, dateLayout)
+	if err != nil {
+		return Date{}, fmt.Errorf("%q is not a date", text)
+	}
+	return Date{Time: t, zoned: zoned}, nil
+}
+
+// ParseTimestamp reads an xs:dateTime. Without a time zone the time is in UTC.
+func ParseTimestamp(text string) (Timestamp, error) {
+	t, zoned, err := parseTime(text, timestampLayout)
+	if err != nil {
+		return Timestamp{}, fmt.Errorf("%q is not a timestamp", text)
+	}
+	return Timestamp{Time: t, zoned: zoned}, nil
+}
+
+// ParseYear reads an xs:gYear. Without a time zone the year is in UTC.
+func ParseYear(text string) (Year, error) {
+	t, zoned, err := parseTime(text, yearLayout)
+	if err != nil {
+		return Year{}, fmt.Errorf("%q is not a year", text)
+	}
+	return Year{Time: t, zoned: zoned}, nil
+}
+
+// parseTime reads a value of an XSD date or time type, whose time zone,
+// when it has one, is Z or an offset such as -05:00
+func parseTime(text, layout string) (time.Time, bool, error) {
+	text = strings.TrimSpace(text)
+	zone := ""
+	switch n := len(text); {
+	case strings.HasSuffix(text, "Z"):
+		text, zone = text[:n-1], "Z"
+	case n > 6 && (text[n-6] == '+' || text[n-6] == '-') && text[n-3] == ':':
+		text, zone = text[:n-6], text[n-6:]
+	}
+	if zone == "" {
+		t, err := time.ParseInLocation(layout, text, time.UTC)
+		return t, false, err
+	}
+	t, err := time.Parse(layout+zoneLayout, text+zone)
+	return t, true, err
+}
+
+func formatTime(t time.Time, zoned bool, layout string) string {
+	if zoned {
+		return t.Format(layout + zoneLayout)
+	}
+	return t.Format(layout)
+}
+
+// Add returns a + b, or ErrOverflow
+func (a Amount) Add(b Amount) (Amount, error) {
+	if (b > 0 && a > math.MaxInt64-b) || (b < 0 && a < math.MinInt64-b) {
+		return 0, ErrOverflow
+	}
+	return a + b, nil
+}
+
+// Sub returns a - b, or ErrOverflow
+func (a Amount) Sub(b Amount) (Amount, error) {
+	if (b < 0 && a > math.MaxInt64+b) || (b > 0 && a < math.MinInt64+b) {
+		return 0, ErrOverflow
+	}
+	return a - b, nil
+}
+
+func (c Checkbox) String() string {
+	if c {
+		return "X"
+	}
+	return ""
+}
+
+func (b Boolean) String() string {
+	return strconv.FormatBool(bool(b))
+}
+
+func (a Amount) String() string {
+	return strconv.FormatInt(int64(a), 10)
+}
+
+func (d Date) String() string {
+	return formatTime(d.Time, d.zoned, dateLayout)
+}
+
+func (t Timestamp) String() string {
+	return formatTime(t.Time, t.zoned, timestampLayout)
+}
+
+func (y Year) String() string {
+	return formatTime(y.Time, y.zoned, yearLayout)
+}
+
+// decodeText reads the text of an element
+func decodeText(d *xml.Decoder, start xml.StartElement) (string, error) {
+	var text string
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return "", err
+	}
+	return text, nil
+}
+
+// encodeText writes an element holding text, or nothing when omit is set
+func encodeText(e *xml.Encoder, start xml.StartElement, text string, omit bool) error {
+	if omit {
+		return nil
+	}
+	return e.EncodeElement(text, start)
+}
+
+// encodeAttr writes an attribute, or nothing when omit is set
+func encodeAttr(name xml.Name, text string, omit bool) (xml.Attr, error) {
+	if omit {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+func (c *Checkbox) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(d, start)
+	if err != nil {
+		return err
+	}
+	*c, err = ParseCheckbox(text)
+	return err
+}
+
+func (c *Checkbox) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*c, err = ParseCheckbox(attr.Value)
+	return err
+}
+
+func (c Checkbox) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, c.String(), !bool(c))
+}
+
+func (c Checkbox) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, c.String(), !bool(c))
+}
+
+func (b *Boolean) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(d, start)
+	if err != nil {
+		return err
+	}
+	*b, err = ParseBoolean(text)
+	return err
+}
+
+func (b *Boolean) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*b, err = ParseBoolean(attr.Value)
+	return err
+}
+
+func (b Boolean) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, b.String(), false)
+}
+
+func (b Boolean) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, b.String(), false)
+}
+
+func (a *Amount) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(d, start)
+	if err != nil {
+		return err
+	}
+	*a, err = ParseAmount(text)
+	return err
+}
+
+func (a *Amount) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*a, err = ParseAmount(attr.Value)
+	return err
+}
+
+func (a Amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, a.String(), false)
+}
+
+func (a Amount) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, a.String(), false)
+}
+
+func (d *Date) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(dec, start)
+	if err != nil {
+		return err
+	}
+	*d, err = ParseDate(text)
+	return err
+}
+
+func (d *Date) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*d, err = ParseDate(attr.Value)
+	return err
+}
+
+func (d Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, d.String(), d.IsZero())
+}
+
+func (d Date) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, d.String(), d.IsZero())
+}
+
+func (t *Timestamp) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(d, start)
+	if err != nil {
+		return err
+	}
+	*t, err = ParseTimestamp(text)
+	return err
+}
+
+func (t *Timestamp) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*t, err = ParseTimestamp(attr.Value)
+	return err
+}
+
+func (t Timestamp) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, t.String(), t.IsZero())
+}
+
+func (t Timestamp) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, t.String(), t.IsZero())
+}
+
+func (y *Year) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, err := decodeText(d, start)
+	if err != nil {
+		return err
+	}
+	*y, err = ParseYear(text)
+	return err
+}
+
+func (y *Year) UnmarshalXMLAttr(attr xml.Attr) (err error) {
+	*y, err = ParseYear(attr.Value)
+	return err
+}
+
+func (y Year) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return encodeText(e, start, y.String(), y.IsZero())
+}
+
+func (y Year) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return encodeAttr(name, y.String(), y.IsZero())
+}
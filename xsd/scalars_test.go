@@ -0,0 +1,157 @@
+package xsd
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseBoolean(t *testing.T) {
+	tests := []struct {
+		text    string
+		want    Boolean
+		wantErr bool
+	}{
+		{text: "true", want: true},
+		{text: "1", want: true},
+		{text: "false", want: false},
+		{text: "0", want: false},
+		{text: " true\n", want: true},
+		{text: "TRUE", wantErr: true},
+		{text: "yes", wantErr: true},
+		{text: "X", wantErr: true},
+		{text: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseBoolean(tt.text)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseBoolean(%q) error = %v, want error %v", tt.text, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseBoolean(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		text string
+		// want is the instant in UTC, and string what String writes back
+		want    time.Time
+		string  string
+		wantErr bool
+	}{
+		{
+			text:   "2024-05-15T10:30:00-05:00",
+			want:   time.Date(2024, 5, 15, 15, 30, 0, 0, time.UTC),
+			string: "2024-05-15T10:30:00-05:00",
+		},
+		{
+			text:   "2024-05-15T10:30:00Z",
+			want:   time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC),
+			string: "2024-05-15T10:30:00Z",
+		},
+		{
+			text:   "2024-05-15T10:30:00+00:00",
+			want:   time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC),
+			string: "2024-05-15T10:30:00Z",
+		},
+		{
+			text:   "2024-05-15T10:30:00.25+02:00",
+			want:   time.Date(2024, 5, 15, 8, 30, 0, 250_000_000, time.UTC),
+			string: "2024-05-15T10:30:00.25+02:00",
+		},
+		{
+			text:   "2024-05-15T10:30:00.123456789",
+			want:   time.Date(2024, 5, 15, 10, 30, 0, 123_456_789, time.UTC),
+			string: "2024-05-15T10:30:00.123456789",
+		},
+		{
+			text:   "2024-05-15T10:30:00",
+			want:   time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC),
+			string: "2024-05-15T10:30:00",
+		},
+		{text: "2024-05-15", wantErr: true},
+		{text: "2024-05-15T25:00:00", wantErr: true},
+		{text: "2024-05-15T10:30:00+5:00", wantErr: true},
+		{text: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTimestamp(tt.text)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTimestamp(%q) error = %v, want error %v", tt.text, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr {
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("ParseTimestamp(%q) = %v, want %v", tt.text, got.UTC(), tt.want)
+		}
+		if got.String() != tt.string {
+			t.Errorf("ParseTimestamp(%q).String() = %q, want %q", tt.text, got.String(), tt.string)
+		}
+	}
+}
+
+func TestAmountArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		op   func(a, b Amount) (Amount, error)
+		a, b Amount
+		want Amount
+		// overflow is set when the result does not fit in an int64
+		overflow bool
+	}{
+		{name: "add", op: Amount.Add, a: 1250, b: -300, want: 950},
+		{name: "add to max", op: Amount.Add, a: math.MaxInt64 - 1, b: 1, want: math.MaxInt64},
+		{name: "add past max", op: Amount.Add, a: math.MaxInt64, b: 1, overflow: true},
+		{name: "add past min", op: Amount.Add, a: math.MinInt64, b: -1, overflow: true},
+		{name: "add extremes", op: Amount.Add, a: math.MinInt64, b: math.MaxInt64, want: -1},
+		{name: "sub", op: Amount.Sub, a: 300, b: 1250, want: -950},
+		{name: "sub past min", op: Amount.Sub, a: math.MinInt64, b: 1, overflow: true},
+		{name: "sub past max", op: Amount.Sub, a: math.MaxInt64, b: -1, overflow: true},
+		{name: "sub min from zero", op: Amount.Sub, a: 0, b: math.MinInt64, overflow: true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.op(tt.a, tt.b)
+		if tt.overflow {
+			if !errors.Is(err, ErrOverflow) {
+				t.Errorf("%s(%d, %d) = %d, %v, want ErrOverflow", tt.name, tt.a, tt.b, got, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s(%d, %d) = %d, %v, want %d", tt.name, tt.a, tt.b, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseAmountOverflow(t *testing.T) {
+	tests := []struct {
+		text     string
+		want     Amount
+		overflow bool
+	}{
+		{text: "9223372036854775807", want: math.MaxInt64},
+		{text: "9223372036854775808", overflow: true},
+		{text: "-9223372036854775809", overflow: true},
+		{text: "+300", want: 300},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.text)
+		if tt.overflow != errors.Is(err, ErrOverflow) {
+			t.Errorf("ParseAmount(%q) error = %v, want overflow %v", tt.text, err, tt.overflow)
+			continue
+		}
+		if !tt.overflow && got != tt.want {
+			t.Errorf("ParseAmount(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
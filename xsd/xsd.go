@@ -0,0 +1,235 @@
+// Package xsd checks generated models against the facets and required
+// elements of the IRS e-file schemas they were generated from
+package xsd
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrMissing reports a required element that is absent or empty
+var ErrMissing = errors.New("is required")
+
+// Validator is implemented by every generated model
+type Validator interface {
+	Validate() error
+}
+
+// Error is a value that breaks its schema, with the path of the element or
+// attribute it was found at, such as ReturnHeader/Filer/EIN
+type Error struct {
+	Path string
+	Err  error
+}
+
+func (e *Error) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Errors collects every error found in an element and its children
+type Errors []*Error
+
+func (errs Errors) Error() string {
+	lines := make([]string, len(errs))
+	for i, err := range errs {
+		lines[i] = err.Error()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Unwrap lets errors.Is and errors.As look through every collected error
+func (errs Errors) Unwrap() []error {
+	unwrapped := make([]error, len(errs))
+	for i, err := range errs {
+		unwrapped[i] = err
+	}
+	return unwrapped
+}
+
+// Add records err, which may itself be an Error or Errors, under path
+func (errs *Errors) Add(path string, err error) {
+	if err == nil {
+		return
+	}
+	var nested Errors
+	var single *Error
+	switch {
+	case errors.As(err, &nested):
+		for _, e := range nested {
+			*errs = append(*errs, &Error{Path: joinPath(path, e.Path), Err: e.Err})
+		}
+	case errors.As(err, &single):
+		*errs = append(*errs, &Error{Path: joinPath(path, single.Path), Err: single.Err})
+	default:
+		*errs = append(*errs, &Error{Path: path, Err: err})
+	}
+}
+
+// Err returns the collected errors, or nil if there are none
+func (errs Errors) Err() error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// Index names one occurrence of a repeated element
+func Index(path string, i int) string {
+	return fmt.Sprintf("%s[%d]", path, i)
+}
+
+func joinPath(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	}
+	return parent + "/" + child
+}
+
+// Pattern compiles an XSD pattern, which must match the whole value
+func Pattern(expr string) *regexp.Regexp {
+	return regexp.MustCompile(AnchorPattern(expr))
+}
+
+// Patterns compiles XSD patterns, all of which a value must match
+func Patterns(exprs ...string) []*regexp.Regexp {
+	patterns := make([]*regexp.Regexp, len(exprs))
+	for i, expr := range exprs {
+		patterns[i] = Pattern(expr)
+	}
+	return patterns
+}
+
+// AnchorPattern turns an XSD pattern into a Go regular expression matching
+// the whole value
+func AnchorPattern(expr string) string {
+	return `^(?:` + expr + `)$`
+}
+
+// Facets are the constraining facets of an XSD simple type. Zero lengths
+// and digit counts and empty bounds are unset.
+type Facets struct {
+	Patterns       []*regexp.Regexp
+	Enumeration    []string
+	Length         int
+	MinLength      int
+	MaxLength      int
+	TotalDigits    int
+	FractionDigits int
+	MinInclusive   string
+	MaxInclusive   string
+	MinExclusive   string
+	MaxExclusive   string
+}
+
+// String checks a string value
+func (f *Facets) String(value string) error {
+	if err := f.lexical(value); err != nil {
+		return err
+	}
+	n := utf8.RuneCountInString(value)
+	switch {
+	case f.Length > 0 && n != f.Length:
+		return fmt.Errorf("%q is %d characters long, not %d", value, n, f.Length)
+	case f.MinLength > 0 && n < f.MinLength:
+		return fmt.Errorf("%q is shorter than %d characters", value, f.MinLength)
+	case f.MaxLength > 0 && n > f.MaxLength:
+		return fmt.Errorf("%q is longer than %d characters", value, f.MaxLength)
+	}
+	return nil
+}
+
+// Int checks an integer value
+func (f *Facets) Int(value int64) error {
+	return f.number(strconv.FormatInt(value, 10), new(big.Rat).SetInt64(value))
+}
+
+// Uint checks a non-negative integer value
+func (f *Facets) Uint(value uint64) error {
+	return f.number(strconv.FormatUint(value, 10), new(big.Rat).SetInt(new(big.Int).SetUint64(value)))
+}
+
+// Float checks a decimal value
+func (f *Facets) Float(value float64) error {
+	rat, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return fmt.Errorf("%v is not a decimal", value)
+	}
+	return f.number(strconv.FormatFloat(value, 'f', -1, 64), rat)
+}
+
+// lexical checks the facets that apply to the text of a value
+func (f *Facets) lexical(text string) error {
+	for _, pattern := range f.Patterns {
+		if !pattern.MatchString(text) {
+			return fmt.Errorf("%q does not match the pattern %s", text, strings.TrimSuffix(strings.TrimPrefix(pattern.String(), "^(?:"), ")$"))
+		}
+	}
+	if len(f.Enumeration) > 0 {
+		for _, allowed := range f.Enumeration {
+			if text == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", text, strings.Join(f.Enumeration, ", "))
+	}
+	return nil
+}
+
+// number checks a numeric value given as text and as a rational
+func (f *Facets) number(text string, value *big.Rat) error {
+	if err := f.lexical(text); err != nil {
+		return err
+	}
+
+	digits := strings.TrimLeft(strings.TrimPrefix(text, "-"), "0")
+	fraction := ""
+	if whole, frac, ok := strings.Cut(digits, "."); ok {
+		fraction = strings.TrimRight(frac, "0")
+		digits = whole + fraction
+	}
+	if f.TotalDigits > 0 && len(digits) > f.TotalDigits {
+		return fmt.Errorf("%s has more than %d digits", text, f.TotalDigits)
+	}
+	if f.FractionDigits > 0 && len(fraction) > f.FractionDigits {
+		return fmt.Errorf("%s has more than %d fraction digits", text, f.FractionDigits)
+	}
+
+	bounds := []struct {
+		bound string
+		fails func(cmp int) bool
+		rule  string
+	}{
+		{f.MinInclusive, func(cmp int) bool { return cmp < 0 }, "less than"},
+		{f.MaxInclusive, func(cmp int) bool { return cmp > 0 }, "greater than"},
+		{f.MinExclusive, func(cmp int) bool { return cmp <= 0 }, "not greater than"},
+		{f.MaxExclusive, func(cmp int) bool { return cmp >= 0 }, "not less than"},
+	}
+	for _, b := range bounds {
+		if b.bound == "" {
+			continue
+		}
+		limit, ok := new(big.Rat).SetString(b.bound)
+		if !ok {
+			continue
+		}
+		if b.fails(value.Cmp(limit)) {
+			return fmt.Errorf("%s is %s %s", text, b.rule, b.bound)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package xsd
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestErrorsAddPaths(t *testing.T) {
+	errBad := errors.New("is bad")
+	nested := func(paths ...string) error {
+		var errs Errors
+		for _, path := range paths {
+			errs.Add(path, errBad)
+		}
+		return errs.Err()
+	}
+
+	tests := []struct {
+		name string
+		path string
+		err  error
+		want []string
+	}{
+		{name: "nil", path: "EIN", err: nil, want: nil},
+		{name: "plain", path: "EIN", err: errBad, want: []string{"EIN"}},
+		{name: "attribute", path: "@documentCnt", err: errBad, want: []string{"@documentCnt"}},
+		{name: "value", path: "EIN", err: &Error{Err: errBad}, want: []string{"EIN"}},
+		{name: "single", path: "Filer", err: &Error{Path: "EIN", Err: errBad}, want: []string{"Filer/EIN"}},
+		{name: "nested", path: "Filer", err: nested("EIN", "BusinessName/BusinessNameLine1Txt"), want: []string{"Filer/EIN", "Filer/BusinessName/BusinessNameLine1Txt"}},
+		{name: "repeated", path: Index("Item", 2), err: nested("Amt"), want: []string{"Item[2]/Amt"}},
+		{name: "chardata", path: "", err: nested("@currencyCd"), want: []string{"@currencyCd"}},
+		{name: "no errors", path: "Filer", err: nested(), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs Errors
+			errs.Add(tt.path, tt.err)
+
+			var got []string
+			for _, e := range errs {
+				if !errors.Is(e, errBad) {
+					t.Errorf("%s lost its cause: %v", e.Path, e.Err)
+				}
+				got = append(got, e.Path)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("paths = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorsErr(t *testing.T) {
+	var errs Errors
+	if err := errs.Err(); err != nil {
+		t.Fatalf("Err of no errors = %v, want nil", err)
+	}
+	errs.Add("Filer", &Error{Path: "EIN", Err: ErrMissing})
+	errs.Add(Index("Item", 0), errors.New("is bad"))
+
+	err := errs.Err()
+	if !errors.Is(err, ErrMissing) {
+		t.Errorf("errors.Is(%v, ErrMissing) = false", err)
+	}
+	if want := "Filer/EIN: is required\nItem[0]: is bad"; err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,392 @@
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/synergos-systems/xsd"
+)
+
+// SchemaFacets holds the restrictions xsd2go drops from a schema version:
+// the facets of each named simple type, by generated type name, and of the
+// elements and attributes that restrict a built-in type inline, by name with
+// attributes prefixed by @
+type SchemaFacets struct {
+	Types    map[string]*TypeFacets
+	Elements map[string]*TypeFacets
+	// Documents holds the inline facets of names restricted differently in
+	// different schema documents, such as ReturnTypeCd, by document name
+	Documents map[string]map[string]*TypeFacets
+}
+
+// TypeFacets are the constraining facets of one simple type, with those of
+// the types it restricts folded in
+type TypeFacets struct {
+	// Base is the built-in XSD type the restrictions end at, such as string
+	Base        string
+	Patterns    []string
+	Enumeration []string
+	// Labels holds the documentation of enumeration values, where the schema has it
+	Labels         map[string]string
+	Length         int
+	MinLength      int
+	MaxLength      int
+	TotalDigits    int
+	FractionDigits int
+	MinInclusive   string
+	MaxInclusive   string
+	MinExclusive   string
+	MaxExclusive   string
+}
+
+// xsdNode is any node of a schema document
+type xsdNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []xsdNode  `xml:",any"`
+}
+
+func (n *xsdNode) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (n *xsdNode) child(name string) *xsdNode {
+	for i := range n.Nodes {
+		if n.Nodes[i].XMLName.Local == name {
+			return &n.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// xsdRestriction is one derivation step of a simple type
+type xsdRestriction struct {
+	base   string
+	facets TypeFacets
+}
+
+// LoadSchemaFacets reads the facets of every schema under dir
+func LoadSchemaFacets(dir string) (*SchemaFacets, error) {
+	restrictions := make(map[string]*xsdRestriction)
+	inline := make(map[string]map[string][]*xsdRestriction)
+
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.EqualFold(filepath.Ext(path), ".xsd") {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		var schema xsdNode
+		if err := xml.Unmarshal(data, &schema); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+
+		for i := range schema.Nodes {
+			node := &schema.Nodes[i]
+			if node.XMLName.Local != "simpleType" || node.attr("name") == "" {
+				continue
+			}
+			if _, ok := restrictions[node.attr("name")]; !ok {
+				if r := readRestriction(node); r != nil {
+					restrictions[node.attr("name")] = r
+				}
+			}
+		}
+		document := strings.TrimSuffix(d.Name(), filepath.Ext(d.Name()))
+		if inline[document] == nil {
+			inline[document] = make(map[string][]*xsdRestriction)
+		}
+		collectInlineFacets(&schema, inline[document])
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	facets := &SchemaFacets{
+		Types:     make(map[string]*TypeFacets),
+		Elements:  make(map[string]*TypeFacets),
+		Documents: make(map[string]map[string]*TypeFacets),
+	}
+	for name := range restrictions {
+		if resolved := resolveFacets(name, restrictions, 0); resolved != nil {
+			facets.Types[xsd2goName(name)] = resolved
+		}
+	}
+
+	// The same name may be restricted differently in different places, and
+	// fields only know the name. Names restricted one way everywhere apply to
+	// every document; the others only within a document that restricts them
+	// one way, such as the ReturnTypeCd of each return header.
+	everywhere := make(map[string][]*xsdRestriction)
+	for _, names := range inline {
+		for name, found := range names {
+			everywhere[name] = append(everywhere[name], found...)
+		}
+	}
+	for name, found := range everywhere {
+		if sameRestrictions(found) {
+			facets.Elements[name] = resolveInline(found[0], restrictions)
+		}
+	}
+	for document, names := range inline {
+		for name, found := range names {
+			if _, ok := facets.Elements[name]; ok || !sameRestrictions(found) {
+				continue
+			}
+			if facets.Documents[document] == nil {
+				facets.Documents[document] = make(map[string]*TypeFacets)
+			}
+			facets.Documents[document][name] = resolveInline(found[0], restrictions)
+		}
+	}
+	return facets, nil
+}
+
+// resolveInline folds an inline restriction into the facets of its base
+func resolveInline(r *xsdRestriction, restrictions map[string]*xsdRestriction) *TypeFacets {
+	resolved := r.facets
+	if base := resolveFacets(localName(r.base), restrictions, 0); base != nil {
+		resolved = mergeFacets(*base, resolved)
+	} else {
+		resolved.Base = localName(r.base)
+	}
+	return &resolved
+}
+
+// forDocument returns the facets as seen from the models of one schema
+// document, with the inline facets only that document has
+func (f *SchemaFacets) forDocument(document string) *SchemaFacets {
+	if f == nil || len(f.Documents[document]) == 0 {
+		return f
+	}
+	elements := make(map[string]*TypeFacets, len(f.Elements)+len(f.Documents[document]))
+	maps.Copy(elements, f.Elements)
+	maps.Copy(elements, f.Documents[document])
+	return &SchemaFacets{Types: f.Types, Elements: elements, Documents: f.Documents}
+}
+
+// collectInlineFacets finds the elements and attributes below node that
+// restrict a type in place
+func collectInlineFacets(node *xsdNode, inline map[string][]*xsdRestriction) {
+	for i := range node.Nodes {
+		child := &node.Nodes[i]
+		name := child.attr("name")
+		if (child.XMLName.Local == "element" || child.XMLName.Local == "attribute") && name != "" {
+			if simple := child.child("simpleType"); simple != nil {
+				if r := readRestriction(simple); r != nil {
+					if child.XMLName.Local == "attribute" {
+						name = "@" + name
+					}
+					inline[name] = append(inline[name], r)
+				}
+			}
+		}
+		collectInlineFacets(child, inline)
+	}
+}
+
+// readRestriction reads the restriction of a simple type, or nil for list and
+// union types
+func readRestriction(simple *xsdNode) *xsdRestriction {
+	restriction := simple.child("restriction")
+	if restriction == nil {
+		return nil
+	}
+	r := &xsdRestriction{base: restriction.attr("base")}
+	var patterns []string
+	for i := range restriction.Nodes {
+		facet := &restriction.Nodes[i]
+		value := facet.attr("value")
+		switch facet.XMLName.Local {
+		case "pattern":
+			patterns = append(patterns, value)
+		case "enumeration":
+			r.facets.Enumeration = append(r.facets.Enumeration, value)
+			if doc := facetDocumentation(facet); doc != "" {
+				if r.facets.Labels == nil {
+					r.facets.Labels = make(map[string]string)
+				}
+				r.facets.Labels[value] = doc
+			}
+		case "length":
+			fmt.Sscan(value, &r.facets.Length)
+		case "minLength":
+			fmt.Sscan(value, &r.facets.MinLength)
+		case "maxLength":
+			fmt.Sscan(value, &r.facets.MaxLength)
+		case "totalDigits":
+			fmt.Sscan(value, &r.facets.TotalDigits)
+		case "fractionDigits":
+			fmt.Sscan(value, &r.facets.FractionDigits)
+		case "minInclusive":
+			r.facets.MinInclusive = value
+		case "maxInclusive":
+			r.facets.MaxInclusive = value
+		case "minExclusive":
+			r.facets.MinExclusive = value
+		case "maxExclusive":
+			r.facets.MaxExclusive = value
+		}
+	}
+
+	// Patterns of one step are alternatives; each step's must match
+	switch len(patterns) {
+	case 0:
+	case 1:
+		r.facets.Patterns = patterns
+	default:
+		r.facets.Patterns = []string{"(?:" + strings.Join(patterns, ")|(?:") + ")"}
+	}
+	return r
+}
+
+// facetDocumentation reads the annotation of a facet as one line
+func facetDocumentation(facet *xsdNode) string {
+	annotation := facet.child("annotation")
+	if annotation == nil {
+		return ""
+	}
+	doc := annotation.child("documentation")
+	if doc == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(doc.Text), " ")
+}
+
+// resolveFacets folds the facets of a named type into those of the types it
+// restricts, or returns nil when the name is not a simple type
+func resolveFacets(name string, restrictions map[string]*xsdRestriction, depth int) *TypeFacets {
+	r, ok := restrictions[name]
+	if !ok || depth > 32 {
+		return nil
+	}
+	base := localName(r.base)
+	if parent := resolveFacets(base, restrictions, depth+1); parent != nil {
+		merged := mergeFacets(*parent, r.facets)
+		return &merged
+	}
+	facets := r.facets
+	facets.Base = base
+	return &facets
+}
+
+// mergeFacets applies the facets of a derived type over those of its base.
+// Patterns of every step must match; the other facets of the derived type win.
+func mergeFacets(base, derived TypeFacets) TypeFacets {
+	merged := base
+	merged.Patterns = append(append([]string(nil), base.Patterns...), derived.Patterns...)
+	if len(derived.Enumeration) > 0 {
+		merged.Enumeration = derived.Enumeration
+		merged.Labels = derived.Labels
+	}
+	if derived.Length > 0 {
+		merged.Length = derived.Length
+	}
+	if derived.MinLength > 0 {
+		merged.MinLength = derived.MinLength
+	}
+	if derived.MaxLength > 0 {
+		merged.MaxLength = derived.MaxLength
+	}
+	if derived.TotalDigits > 0 {
+		merged.TotalDigits = derived.TotalDigits
+	}
+	if derived.FractionDigits > 0 {
+		merged.FractionDigits = derived.FractionDigits
+	}
+	if derived.MinInclusive != "" {
+		merged.MinInclusive = derived.MinInclusive
+	}
+	if derived.MaxInclusive != "" {
+		merged.MaxInclusive = derived.MaxInclusive
+	}
+	if derived.MinExclusive != "" {
+		merged.MinExclusive = derived.MinExclusive
+	}
+	if derived.MaxExclusive != "" {
+		merged.MaxExclusive = derived.MaxExclusive
+	}
+	return merged
+}
+
+// sameRestrictions reports whether every inline restriction of a name is the same
+func sameRestrictions(found []*xsdRestriction) bool {
+	for _, r := range found[1:] {
+		if r.base != found[0].base || !reflect.DeepEqual(r.facets, found[0].facets) {
+			return false
+		}
+	}
+	return true
+}
+
+// usablePatterns drops the patterns Go's regexp cannot compile, such as
+// those using the XSD-only \i and \c escapes or class subtraction
+func (f *TypeFacets) usablePatterns() (usable, dropped []string) {
+	for _, pattern := range f.Patterns {
+		if _, err := regexp.Compile(xsd.AnchorPattern(pattern)); err != nil {
+			dropped = append(dropped, pattern)
+			continue
+		}
+		usable = append(usable, pattern)
+	}
+	return usable, dropped
+}
+
+// Empty reports whether the facets constrain nothing
+func (f *TypeFacets) Empty() bool {
+	return len(f.Patterns) == 0 && len(f.Enumeration) == 0 && f.Length == 0 && f.MinLength == 0 &&
+		f.MaxLength == 0 && f.TotalDigits == 0 && f.FractionDigits == 0 &&
+		f.MinInclusive == "" && f.MaxInclusive == "" && f.MinExclusive == "" && f.MaxExclusive == ""
+}
+
+// localName strips the namespace prefix from a QName such as xsd:string
+func localName(qname string) string {
+	if _, local, ok := strings.Cut(qname, ":"); ok {
+		return local
+	}
+	return qname
+}
+
+// xsd2goName is the Go name xsd2go gives a schema name: each run of capitals
+// keeps its first letter upper case, so EINType becomes Eintype and
+// USAmountType UsamountType
+func xsd2goName(name string) string {
+	var out strings.Builder
+	upperRun := false
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upperRun = false
+			continue
+		}
+		switch {
+		case out.Len() == 0:
+			out.WriteRune(unicode.ToUpper(r))
+		case upperRun && unicode.IsUpper(r):
+			out.WriteRune(unicode.ToLower(r))
+		default:
+			out.WriteRune(r)
+		}
+		upperRun = unicode.IsUpper(r)
+	}
+	return out.String()
+}
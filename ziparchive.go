@@ -0,0 +1,73 @@
+package main
+
+import (
+	"archive/zip"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const zipBaseDir = "data/990_zips"
+
+// forEachZipXML opens an archive and calls fn for each XML entry from a pool
+// of workers. When only is non-nil, entries whose names are not in it are
+// skipped. Entries of one archive can be read concurrently because each
+// Open gets its own section of the underlying file.
+func forEachZipXML(zipPath string, workers int, only map[string]bool, fn func(*zip.File)) error {
+	reader, err := zip.OpenReader(zipPath)
+	if err != nil {
+		return fmt.Errorf("failed to open ZIP file: %w", err)
+	}
+	defer reader.Close()
+
+	forEachZipEntry(&reader.Reader, workers, only, fn)
+	return nil
+}
+
+// forEachZipEntry calls fn for each XML entry of an open archive from a pool
+// of workers, skipping entries not in only when it is non-nil
+func forEachZipEntry(reader *zip.Reader, workers int, only map[string]bool, fn func(*zip.File)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	entries := make(chan *zip.File)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range entries {
+				fn(entry)
+			}
+		}()
+	}
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() || !strings.HasSuffix(strings.ToLower(file.Name), ".xml") {
+			continue
+		}
+		if only != nil && !only[file.Name] {
+			continue
+		}
+		entries <- file
+	}
+	close(entries)
+	wg.Wait()
+}
+
+// archiveProvenance names the archive and entry path an extracted file came
+// from, assuming the <zipDir>/<archive>/<entry> layout used by unzip
+func archiveProvenance(zipDir, filePath string) (archive, entry string) {
+	rel, err := filepath.Rel(zipDir, filePath)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return "", filepath.ToSlash(filePath)
+	}
+
+	archive, entry, found := strings.Cut(filepath.ToSlash(rel), "/")
+	if !found {
+		return "", archive
+	}
+	return archive + ".zip", entry
+}